@@ -18,12 +18,12 @@ import (
 )
 
 type AnalyticsService struct {
-	subscriber *redis.RedisEventSubscriber
+	subscriber domain.EventSubscriber
 	bidRepo    *mysql.MySQLBidRepository
 	log        logger.Logger
 }
 
-func NewAnalyticsService(subscriber *redis.RedisEventSubscriber, bidRepo *mysql.MySQLBidRepository, log logger.Logger) *AnalyticsService {
+func NewAnalyticsService(subscriber domain.EventSubscriber, bidRepo *mysql.MySQLBidRepository, log logger.Logger) *AnalyticsService {
 	return &AnalyticsService{
 		subscriber: subscriber,
 		bidRepo:    bidRepo,
@@ -35,8 +35,12 @@ func (as *AnalyticsService) Start(ctx context.Context) error {
 	as.log.Info("Starting analytics service")
 
 	return as.subscriber.SubscribeToBidEvents(ctx, func(event *domain.BidEvent) error {
-		// Only store successful bid events
-		if event.Type == domain.BidAccepted {
+		// Only store successful bid events. Sealed bids are kept for analytics even
+		// though they are never broadcast while the auction runs, retractions are
+		// kept as the price moves they cause, and rate limiter hits to spot abusive clients.
+		switch event.Type {
+		case domain.BidAccepted, domain.BidRetracted, domain.BuyNowExecuted, domain.SealedBidPlaced,
+			domain.DutchPriceAccepted, domain.BidRateLimited:
 			as.log.Info("Storing bid event", "auction_id", event.AuctionID, "user_id", event.UserID, "amount", event.Amount)
 			return as.bidRepo.SaveBidEvent(context.Background(), event)
 		}
@@ -89,7 +93,19 @@ func main() {
 	}
 
 	// Initialize services
-	eventSubscriber := redis.NewRedisEventSubscriber(rdb, log)
+	// Analytics instances share one consumer group on the stream, so each event
+	// is stored once and an instance that is down catches up when it is back
+	delivery := redis.DeliveryPolicy{
+		MaxAttempts: cfg.Events.HandlerMaxAttempts,
+		Backoff:     cfg.Events.HandlerBackoff,
+		DeadLetters: redis.NewDeadLetterStore(rdb),
+	}
+	var eventSubscriber domain.EventSubscriber = redis.NewRedisEventSubscriber(rdb, "analytics-service:"+cfg.Instance.ID,
+		delivery, log)
+	if cfg.Events.UseStreams() {
+		eventSubscriber = redis.NewStreamEventSubscriber(rdb, "analytics", cfg.Instance.ID, cfg.Events.ClaimIdle,
+			delivery, log)
+	}
 	bidRepo := mysql.NewMySQLBidRepository(db)
 
 	analyticsService := NewAnalyticsService(eventSubscriber, bidRepo, log)
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/services"
+	"auction-system/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DeadLetterHandler struct {
+	deadLetterService *services.DeadLetterService
+	log               logger.Logger
+}
+
+type DeadLetterResponse struct {
+	ID        string    `json:"id"`
+	Consumer  string    `json:"consumer"`
+	EventID   string    `json:"event_id,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	AuctionID string    `json:"auction_id,omitempty"`
+	Payload   string    `json:"payload"`
+	Error     string    `json:"error"`
+	Attempts  int       `json:"attempts"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+func newDeadLetterResponse(letter *domain.DeadLetter) DeadLetterResponse {
+	return DeadLetterResponse{
+		ID:        letter.ID,
+		Consumer:  letter.Consumer,
+		EventID:   letter.EventID,
+		Type:      string(letter.Type),
+		AuctionID: letter.AuctionID,
+		Payload:   letter.Payload,
+		Error:     letter.Error,
+		Attempts:  letter.Attempts,
+		FailedAt:  letter.FailedAt,
+	}
+}
+
+func NewDeadLetterHandler(deadLetterService *services.DeadLetterService, log logger.Logger) *DeadLetterHandler {
+	return &DeadLetterHandler{
+		deadLetterService: deadLetterService,
+		log:               log,
+	}
+}
+
+// ListDeadLetters shows the newest dead letters, filtered by the optional
+// consumer query parameter
+func (h *DeadLetterHandler) ListDeadLetters(c echo.Context) error {
+	limit := defaultListLimit
+	if value := c.QueryParam("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 || parsed > maxListLimit {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": fmt.Sprintf("limit must be between 1 and %d", maxListLimit),
+			})
+		}
+		limit = parsed
+	}
+
+	letters, err := h.deadLetterService.ListDeadLetters(c.Request().Context(), c.QueryParam("consumer"), limit)
+	if err != nil {
+		return h.deadLetterError(c, "list", err)
+	}
+
+	response := make([]DeadLetterResponse, 0, len(letters))
+	for _, letter := range letters {
+		response = append(response, newDeadLetterResponse(letter))
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"dead_letters": response,
+	})
+}
+
+func (h *DeadLetterHandler) GetDeadLetter(c echo.Context) error {
+	letter, err := h.deadLetterService.GetDeadLetter(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return h.deadLetterError(c, "load", err)
+	}
+
+	return c.JSON(http.StatusOK, newDeadLetterResponse(letter))
+}
+
+// ReplayDeadLetter sends the event back to the consumer it failed in
+func (h *DeadLetterHandler) ReplayDeadLetter(c echo.Context) error {
+	actor, err := bindActor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	letter, err := h.deadLetterService.ReplayDeadLetter(c.Request().Context(), c.Param("id"), actor)
+	if err != nil {
+		return h.deadLetterError(c, "replay", err)
+	}
+
+	return c.JSON(http.StatusAccepted, newDeadLetterResponse(letter))
+}
+
+func (h *DeadLetterHandler) DiscardDeadLetter(c echo.Context) error {
+	actor, err := bindActor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	if err := h.deadLetterService.DiscardDeadLetter(c.Request().Context(), c.Param("id"), actor); err != nil {
+		return h.deadLetterError(c, "discard", err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *DeadLetterHandler) deadLetterError(c echo.Context, action string, err error) error {
+	if errors.Is(err, domain.ErrDeadLetterNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Dead letter not found"})
+	}
+
+	h.log.Error("Failed to "+action+" dead letters", "id", c.Param("id"), "error", err)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to " + action + " dead letters"})
+}
+
+// bindActor reads the optional StateChangeRequest body for who is acting
+func bindActor(c echo.Context) (string, error) {
+	var req StateChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return "", err
+	}
+	if req.Actor == "" {
+		return "admin", nil
+	}
+	return req.Actor, nil
+}
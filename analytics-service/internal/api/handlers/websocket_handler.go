@@ -16,8 +16,9 @@ type WebSocketHandlers struct {
 }
 
 func NewWebSocketHandlers(bidService *services.BidService, auctionRepo repositories.AuctionRepository,
+	itemRepo repositories.ItemRepository, saleRepo repositories.SaleRepository,
 	connManager *websocket.ConnectionManager, log logger.Logger) *WebSocketHandlers {
-	wsHandler := websocket.NewWebSocketHandler(bidService, auctionRepo, connManager, log)
+	wsHandler := websocket.NewWebSocketHandler(bidService, auctionRepo, itemRepo, saleRepo, connManager, log)
 	return &WebSocketHandlers{
 		wsHandler: wsHandler,
 	}
@@ -26,3 +27,7 @@ func NewWebSocketHandlers(bidService *services.BidService, auctionRepo repositor
 func (h *WebSocketHandlers) HandleConnection(w http.ResponseWriter, r *http.Request) {
 	h.wsHandler.HandleConnection(w, r)
 }
+
+func (h *WebSocketHandlers) HandleSaleConnection(w http.ResponseWriter, r *http.Request) {
+	h.wsHandler.HandleSaleConnection(w, r)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	"auction-system/internal/domain"
+
 	"github.com/spf13/viper"
 )
 
@@ -13,6 +15,10 @@ type Config struct {
 	MySQL    MySQLConfig    `mapstructure:"mysql"`
 	Leader   LeaderConfig   `mapstructure:"leader"`
 	Instance InstanceConfig `mapstructure:"instance"`
+
+	ExchangeRates ExchangeRatesConfig `mapstructure:"exchange_rates"`
+	RateLimit     RateLimitConfig     `mapstructure:"rate_limit"`
+	Events        EventsConfig        `mapstructure:"events"`
 }
 
 type ServerConfig struct {
@@ -41,6 +47,48 @@ type InstanceConfig struct {
 	ID string `mapstructure:"id"`
 }
 
+// ExchangeRatesConfig points at a static rates file for display conversions;
+// an empty file disables conversions
+type ExchangeRatesConfig struct {
+	File string `mapstructure:"file"`
+}
+
+// RateLimitConfig sets the bid token buckets in bids per second and burst size:
+// one per user across all auctions and one per user and auction. A zero rate
+// or burst disables that bucket.
+type RateLimitConfig struct {
+	UserRate     float64 `mapstructure:"user_rate"`
+	UserBurst    int     `mapstructure:"user_burst"`
+	AuctionRate  float64 `mapstructure:"auction_rate"`
+	AuctionBurst int     `mapstructure:"auction_burst"`
+}
+
+func (c RateLimitConfig) PerUser() domain.RateLimit {
+	return domain.RateLimit{Rate: c.UserRate, Burst: c.UserBurst}
+}
+
+func (c RateLimitConfig) PerAuction() domain.RateLimit {
+	return domain.RateLimit{Rate: c.AuctionRate, Burst: c.AuctionBurst}
+}
+
+// EventsConfig picks how events travel between services: "pubsub" is
+// fire-and-forget, "streams" keeps them in a Redis stream of at most
+// StreamMaxLen entries (approximately) that consumer groups read. Events left
+// unacknowledged for ClaimIdle are handed to another consumer. Either way a
+// failing handler gets HandlerMaxAttempts tries, backing off from
+// HandlerBackoff, before the event is dead-lettered.
+type EventsConfig struct {
+	Transport          string        `mapstructure:"transport"`
+	StreamMaxLen       int64         `mapstructure:"stream_max_len"`
+	ClaimIdle          time.Duration `mapstructure:"claim_idle"`
+	HandlerMaxAttempts int           `mapstructure:"handler_max_attempts"`
+	HandlerBackoff     time.Duration `mapstructure:"handler_backoff"`
+}
+
+func (c EventsConfig) UseStreams() bool {
+	return c.Transport == "streams"
+}
+
 func Load() (*Config, error) {
 	// Set default values
 	viper.SetDefault("server.port", 8080)
@@ -53,7 +101,16 @@ func Load() (*Config, error) {
 	viper.SetDefault("mysql.max_idle_conns", 10)
 	viper.SetDefault("mysql.conn_max_lifetime", 5*time.Minute)
 	viper.SetDefault("leader.ttl", 30*time.Second)
-	viper.SetDefault("instance.id", "auction-service-1")
+	viper.SetDefault("exchange_rates.file", "")
+	viper.SetDefault("rate_limit.user_rate", 5)
+	viper.SetDefault("rate_limit.user_burst", 10)
+	viper.SetDefault("rate_limit.auction_rate", 2)
+	viper.SetDefault("rate_limit.auction_burst", 5)
+	viper.SetDefault("events.transport", "pubsub")
+	viper.SetDefault("events.stream_max_len", 100000)
+	viper.SetDefault("events.claim_idle", 30*time.Second)
+	viper.SetDefault("events.handler_max_attempts", 3)
+	viper.SetDefault("events.handler_backoff", 200*time.Millisecond)
 
 	// Configuration file settings
 	viper.SetConfigName("config")
@@ -77,6 +134,16 @@ func Load() (*Config, error) {
 	viper.BindEnv("mysql.conn_max_lifetime", "MYSQL_CONN_MAX_LIFETIME")
 	viper.BindEnv("leader.ttl", "LEADER_TTL")
 	viper.BindEnv("instance.id", "INSTANCE_ID")
+	viper.BindEnv("exchange_rates.file", "EXCHANGE_RATES_FILE")
+	viper.BindEnv("rate_limit.user_rate", "RATE_LIMIT_USER_RATE")
+	viper.BindEnv("rate_limit.user_burst", "RATE_LIMIT_USER_BURST")
+	viper.BindEnv("rate_limit.auction_rate", "RATE_LIMIT_AUCTION_RATE")
+	viper.BindEnv("rate_limit.auction_burst", "RATE_LIMIT_AUCTION_BURST")
+	viper.BindEnv("events.transport", "EVENTS_TRANSPORT")
+	viper.BindEnv("events.stream_max_len", "EVENTS_STREAM_MAX_LEN")
+	viper.BindEnv("events.claim_idle", "EVENTS_CLAIM_IDLE")
+	viper.BindEnv("events.handler_max_attempts", "EVENTS_HANDLER_MAX_ATTEMPTS")
+	viper.BindEnv("events.handler_backoff", "EVENTS_HANDLER_BACKOFF")
 
 	// Read configuration file (optional - will use defaults/env vars if not found)
 	if err := viper.ReadInConfig(); err != nil {
@@ -91,6 +158,10 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
 
@@ -107,9 +178,36 @@ func LoadFromFile(configPath string) (*Config, error) {
 		return nil, err
 	}
 
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
 
+// validate rejects settings that would otherwise be silently ignored. An empty
+// transport, as LoadFromFile gives without an events section, is pub/sub, and
+// zero handler attempts is a single try. The instance ID has no default: it
+// names the instance's consumer group and dead letters, which must not be shared
+// by accident.
+func (c *Config) validate() error {
+	if c.Instance.ID == "" {
+		return fmt.Errorf("instance.id must be set, e.g. with INSTANCE_ID, and be unique per instance")
+	}
+	switch c.Events.Transport {
+	case "", "pubsub", "streams":
+	default:
+		return fmt.Errorf("events.transport must be pubsub or streams, got %q", c.Events.Transport)
+	}
+	if c.Events.UseStreams() && (c.Events.StreamMaxLen <= 0 || c.Events.ClaimIdle <= 0) {
+		return fmt.Errorf("events.stream_max_len and events.claim_idle must be positive")
+	}
+	if c.Events.HandlerMaxAttempts < 0 || c.Events.HandlerBackoff < 0 {
+		return fmt.Errorf("events.handler_max_attempts and events.handler_backoff cannot be negative")
+	}
+	return nil
+}
+
 // GetConfigString returns a formatted string representation of the config
 func (c *Config) GetConfigString() string {
 	return fmt.Sprintf(
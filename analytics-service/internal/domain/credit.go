@@ -0,0 +1,33 @@
+package domain
+
+// CreditLimit caps what a bidder can have at stake in one currency
+type CreditLimit struct {
+	UserID   string
+	Currency string
+	Limit    Money
+}
+
+// CreditAccount is a bidder's credit in one currency. Holds reserve credit on
+// every auction the bidder may have to pay for: the English auctions they lead
+// (up to their proxy ceiling), their sealed bids, and won auctions until they
+// are settled.
+type CreditAccount struct {
+	UserID    string
+	Currency  string
+	Limit     Money
+	Unlimited bool             // no credit limit set; bids are not checked
+	Holds     map[string]Money // by auction ID
+}
+
+func (a *CreditAccount) Held() Money {
+	var held Money
+	for _, amount := range a.Holds {
+		held += amount
+	}
+	return held
+}
+
+// Available is the credit left for new bids; it can be negative after a limit is lowered
+func (a *CreditAccount) Available() Money {
+	return a.Limit - a.Held()
+}
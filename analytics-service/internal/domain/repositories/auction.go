@@ -3,11 +3,24 @@ package repositories
 import (
 	"auction-system/internal/domain"
 	"context"
+	"time"
 )
 
 type AuctionRepository interface {
 	CreateAuction(ctx context.Context, auction *domain.Auction) error
 	GetAuction(ctx context.Context, auctionID string) (*domain.Auction, error)
 	UpdateAuctionStatus(ctx context.Context, auctionID string, status domain.AuctionStatus) error
+	// TransitionAuctionStatus moves the auction from transition.From to transition.To only if
+	// it is still in transition.From, and records the transition and, unless it is nil, the
+	// event announcing it in the event outbox in the same transaction
+	TransitionAuctionStatus(ctx context.Context, transition *domain.StatusTransition, event *domain.BidEvent) error
+	GetStatusHistory(ctx context.Context, auctionID string) ([]*domain.StatusTransition, error)
+	UpdateAuctionEndTime(ctx context.Context, auctionID string, endTime time.Time) error
+	// UpdateAuctionPausedAt records when the auction was paused, a zero time clears it
+	UpdateAuctionPausedAt(ctx context.Context, auctionID string, pausedAt time.Time) error
 	GetActiveAuctions(ctx context.Context) ([]*domain.Auction, error)
+	// ListAuctions returns one page of auctions matching the filter and the cursor
+	// for the next page, which is nil on the last page
+	ListAuctions(ctx context.Context, filter *domain.AuctionFilter) ([]*domain.Auction, *domain.AuctionCursor, error)
+	UpdateAuctionPrice(ctx context.Context, auctionID string, price domain.Money) error
 }
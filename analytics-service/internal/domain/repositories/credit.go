@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"auction-system/internal/domain"
+	"context"
+)
+
+type CreditRepository interface {
+	// SetCreditLimit creates or replaces the bidder's limit in the limit's currency
+	SetCreditLimit(ctx context.Context, limit *domain.CreditLimit) error
+	GetCreditLimits(ctx context.Context, userID string) ([]*domain.CreditLimit, error)
+	ListCreditLimits(ctx context.Context) ([]*domain.CreditLimit, error)
+}
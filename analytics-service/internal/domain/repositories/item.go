@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"auction-system/internal/domain"
+	"context"
+)
+
+type ItemRepository interface {
+	CreateItem(ctx context.Context, item *domain.Item) error
+	GetItem(ctx context.Context, itemID string) (*domain.Item, error)
+	// GetItems loads several items at once, keyed by ID. Unknown IDs are left out.
+	GetItems(ctx context.Context, itemIDs []string) (map[string]*domain.Item, error)
+	// UpdateItem also refreshes the title and category copied onto the item's auctions
+	UpdateItem(ctx context.Context, item *domain.Item) error
+	// DeleteItem returns domain.ErrItemInUse while an auction still references the item
+	DeleteItem(ctx context.Context, itemID string) error
+
+	CreateCategory(ctx context.Context, category *domain.Category) error
+	GetCategory(ctx context.Context, categoryID string) (*domain.Category, error)
+	ListCategories(ctx context.Context) ([]*domain.Category, error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"auction-system/internal/domain"
+	"context"
+	"time"
+)
+
+// OutboxRepository reads the event outbox. Events are added to it by
+// AuctionRepository.TransitionAuctionStatus, in the transaction of the status
+// change they announce.
+type OutboxRepository interface {
+	// ListUnsentEvents returns up to limit events recorded before the given time
+	// that are not marked sent, oldest first
+	ListUnsentEvents(ctx context.Context, recordedBefore time.Time, limit int) ([]*domain.BidEvent, error)
+	MarkEventSent(ctx context.Context, eventID string, sentAt time.Time) error
+}
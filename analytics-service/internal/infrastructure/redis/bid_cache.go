@@ -2,112 +2,755 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"auction-system/internal/domain"
+	"auction-system/pkg/utils"
 
 	"github.com/go-redis/redis/v8"
 )
 
 type BidCacheImpl struct {
 	client *redis.Client
+	events EventSink
 }
 
-func NewBidCache(client *redis.Client) *BidCacheImpl {
-	return &BidCacheImpl{client: client}
+// NewBidCache publishes the events of its scripts to events
+func NewBidCache(client *redis.Client, events EventSink) *BidCacheImpl {
+	return &BidCacheImpl{client: client, events: events}
 }
 
-func (r *BidCacheImpl) InitializeBidding(ctx context.Context, auctionID string,
-	startingBid float64, incrementRule float64) error {
-	key := fmt.Sprintf("auction:%s", auctionID)
+// Amounts are stored as integer cents so the Lua scripts compare and add them exactly
+func (r *BidCacheImpl) InitializeBidding(ctx context.Context, auction *domain.Auction, incrementRule domain.Money) error {
+	key := fmt.Sprintf("auction:%s", auction.ID)
+
+	currency, err := domain.LookupCurrency(auction.Currency)
+	if err != nil {
+		return err
+	}
+
+	buyNowUntil := int64(0)
+	if !auction.BuyNowUntil.IsZero() {
+		buyNowUntil = auction.BuyNowUntil.Unix()
+	}
 
 	return r.client.HMSet(ctx, key,
-		"current_bid", fmt.Sprintf("%.2f", startingBid),
+		"auction_type", string(auction.Type),
+		"current_bid", cents(auction.StartBid),
 		"winner_id", "",
-		"increment_rule", fmt.Sprintf("%.2f", incrementRule),
+		"increment_rule", cents(incrementRule),
+		"reserve_price", cents(auction.ReservePrice),
+		"buy_now_price", cents(auction.BuyNowPrice),
+		"currency", currency.Code,
+		"minor_step", cents(currency.Step()),
+		"buy_now_until", buyNowUntil,
+		"closed", 0,
+		"frozen", 0,
+		"bid_count", 0,
+		"sale_id", auction.SaleID,
+		"end_time", auction.EndTime.Unix(),
 		"last_updated", time.Now().Unix(),
 	).Err()
 }
 
-func (r *BidCacheImpl) AtomicBidUpdate(ctx context.Context, auctionID, userID string, amount float64) (bool, error) {
+// biddingLua is shared by the bid and retraction scripts and expects
+// auction_key to be set and eventLua to come first. English bids are applied to a state table rather than
+// the hash, so a retraction replays the ladder under exactly the rules the bids
+// were placed under, and moves the credit holds the same way.
+const biddingLua = `
+        local max_bids_key = auction_key .. ":max_bids"
+        local ladder_key = auction_key .. ":ladder"
+        local minor_step = tonumber(redis.call('HGET', auction_key, 'minor_step') or "1")
+        
+        -- Tier amounts are decimal strings in the JSON, everything else is in cents
+        local function to_cents(value)
+            return math.floor(tonumber(value or "0") * 100 + 0.5)
+        end
+        
+        -- Per-auction increment tiers, written by BiddingRuleDaoImpl.SaveAuctionRules
+        local tiers_json = redis.call('GET', 'bid_validation_rules:' .. KEYS[1])
+        local function increment_for(price, fallback)
+            if not tiers_json then
+                return fallback
+            end
+            for _, tier in ipairs(cjson.decode(tiers_json).tiers) do
+                local upper = to_cents(tier.to)
+                if price >= to_cents(tier.from) and (upper == 0 or price < upper) then
+                    if tier.percent and tier.percent > 0 then
+                        local increment = math.floor(price * tier.percent / 100 + 0.5)
+                        return math.max(minor_step, math.floor(increment / minor_step + 0.5) * minor_step)
+                    end
+                    return to_cents(tier.increment)
+                end
+            end
+            return fallback
+        end
+        
+        -- Holds reserve bidders' credit for auctions they may have to pay for. They
+        -- are kept per bidder and currency, which the credit check sums, and per
+        -- auction, so they can be released when the auction is over.
+        local auction_currency = redis.call('HGET', auction_key, 'currency') or "USD"
+        local auction_holds_key = auction_key .. ":holds"
+        local function bidder_holds_key(user_id)
+            return "bidder:" .. user_id .. ":holds:" .. auction_currency
+        end
+        
+        local function set_hold(user_id, amount)
+            redis.call('HSET', bidder_holds_key(user_id), KEYS[1], string.format("%d", amount))
+            redis.call('HSET', auction_holds_key, user_id, string.format("%d", amount))
+        end
+        
+        local function release_hold(user_id)
+            redis.call('HDEL', bidder_holds_key(user_id), KEYS[1])
+            redis.call('HDEL', auction_holds_key, user_id)
+        end
+        
+        -- Bidders without a credit limit are not limited. Their own hold on this
+        -- auction is left out, the new exposure replaces it.
+        local function has_credit(user_id, exposure)
+            local limit = redis.call('HGET', "bidder:" .. user_id .. ":credit_limits", auction_currency)
+            if not limit then
+                return true
+            end
+            local held = 0
+            local holds = redis.call('HGETALL', bidder_holds_key(user_id))
+            for i = 1, #holds, 2 do
+                if holds[i] ~= KEYS[1] then
+                    held = held + tonumber(holds[i + 1])
+                end
+            end
+            return exposure <= tonumber(limit) - held
+        end
+        
+        -- The state holds the visible price, the leader, the next increment, the
+        -- bid count and the proxy ceilings by bidder
+        local function english_state(price, winner, increment)
+            local state = {
+                price = price,
+                winner = winner or "",
+                increment = increment,
+                bid_count = tonumber(redis.call('HGET', auction_key, 'bid_count') or "0"),
+                ceilings = {},
+            }
+            if state.winner ~= "" then
+                local ceiling = redis.call('HGET', max_bids_key, state.winner)
+                if ceiling then
+                    state.ceilings[state.winner] = tonumber(ceiling)
+                end
+            end
+            return state
+        end
+        
+        local function save_english(state, timestamp)
+            local previous_winner = redis.call('HGET', auction_key, 'winner_id') or ""
+            redis.call('HSET', auction_key,
+                'current_bid', string.format("%d", state.price),
+                'winner_id', state.winner,
+                'increment_rule', string.format("%d", state.increment),
+                'bid_count', string.format("%d", state.bid_count),
+                'last_updated', timestamp)
+            redis.call('DEL', max_bids_key)
+            local ceiling = state.ceilings[state.winner]
+            if ceiling and ceiling > state.price then
+                redis.call('HSET', max_bids_key, state.winner, string.format("%d", ceiling))
+            end
+        
+            -- Only the leader holds credit, enough for the ceiling the proxy may still bid up to
+            if previous_winner ~= "" and previous_winner ~= state.winner then
+                release_hold(previous_winner)
+            end
+            if state.winner ~= "" then
+                set_hold(state.winner, math.max(state.price, ceiling or 0))
+            end
+        end
+        
+        local function english_bid(state, user_id, amount, max_amount)
+            -- The leading bidder can only raise their ceiling, never their own visible
+            -- price, and never lower it: that would also shrink their credit hold
+            if state.winner ~= "" and state.winner == user_id then
+                if max_amount < (state.price + state.increment) then
+                    return 0, "insufficient_increment"
+                end
+                if max_amount <= (state.ceilings[user_id] or 0) then
+                    return 0, "max_bid_not_raised"
+                end
+                state.ceilings[user_id] = max_amount
+                return 1, "max_bid_updated"
+            end
+        
+            if amount < (state.price + state.increment) then
+                return 0, "insufficient_increment"
+            end
+        
+            -- Every bid past this point moves the visible price, whoever ends up leading
+            state.bid_count = state.bid_count + 1
+        
+            local leader_max = state.price
+            if state.winner ~= "" then
+                leader_max = math.max(state.price, state.ceilings[state.winner] or state.price)
+            end
+        
+            if max_amount > leader_max then
+                -- Challenger takes the lead at one increment over the previous ceiling
+                local price = math.max(amount, math.min(max_amount, leader_max + state.increment))
+                state.ceilings[state.winner] = nil
+                state.winner = user_id
+                state.price = price
+                state.increment = increment_for(price, state.increment)
+                if max_amount > price then
+                    state.ceilings[user_id] = max_amount
+                end
+                return 1, "success"
+            end
+        
+            -- Leader's proxy covers the challenge; ties go to the earlier bidder
+            state.price = math.min(leader_max, max_amount + state.increment)
+            state.increment = increment_for(state.price, state.increment)
+            return 0, "outbid_by_proxy"
+        end
+`
+
+// AtomicBidUpdate validates and applies a bid in the auction's own currency. An
+// empty currency means the bidder did not name one and bids in the native currency.
+// Outcomes of bids with a bid ID are kept for domain.BidIDTTL so resends are not applied twice.
+func (r *BidCacheImpl) AtomicBidUpdate(ctx context.Context, bid *domain.BidRequest) (*domain.BidOutcome, error) {
+	// Max bids (proxy ceilings) live in a separate hash so they never leave Redis
+	// through GetCurrentBid or the published events, which only carry the visible price.
 	luaScript := `
         local auction_key = "auction:" .. KEYS[1]
+` + eventLua + biddingLua + `
+        local sealed_bids_key = auction_key .. ":sealed_bids"
+        local sealed_times_key = auction_key .. ":sealed_bid_times"
         local current_amount = redis.call('HGET', auction_key, 'current_bid')
+        local winner_id = redis.call('HGET', auction_key, 'winner_id')
         local increment_rule = redis.call('HGET', auction_key, 'increment_rule')
         
-        if current_amount == false then
-            return {0, "auction_not_found"}
+        -- Replies carry the auction as the bidder sees it after the bid, mirroring
+        -- LocalAuctionCache.IsLeading and NextMinimumBid
+        local function reply(accepted, reason, replayed)
+            local state = redis.call('HMGET', auction_key, 'current_bid', 'winner_id', 'increment_rule', 'auction_type')
+            local price = tonumber(state[1] or "0")
+            local next_minimum = price + tonumber(state[3] or "500")
+            local leading = 0
+            if state[4] == "sealed_first_price" or state[4] == "sealed_second_price" then
+                next_minimum = price
+            else
+                if state[4] == "dutch" then
+                    next_minimum = price
+                end
+                if state[2] and state[2] ~= "" and state[2] == ARGV[2] then
+                    leading = 1
+                end
+            end
+            return {accepted, reason, replayed, price, leading, next_minimum}
         end
         
-        local current = tonumber(current_amount)
-        local new_amount = tonumber(ARGV[1])
-        local required_increment = tonumber(increment_rule or "5")
-        
-        if new_amount >= (current + required_increment) then
-            redis.call('HSET', auction_key, 
-                'current_bid', ARGV[1], 
-                'winner_id', ARGV[2], 
-                'last_updated', ARGV[3])
+        -- A resent bid (same bidder and bid_id) gets the outcome of its first attempt
+        local bid_id_key = auction_key .. ":bid_ids:" .. ARGV[2] .. ":" .. ARGV[6]
+        if ARGV[6] ~= "" then
+            local seen = redis.call('GET', bid_id_key)
+            if seen then
+                local accepted, reason = string.match(seen, "^(%d):(.*)$")
+                return reply(tonumber(accepted), reason, 1)
+            end
+        end
+        
+        local function apply()
+            if current_amount == false then
+                return {0, "auction_not_found"}
+            end
+        
+            local current = tonumber(current_amount)
+            local new_amount = tonumber(ARGV[1])
+            local max_amount = math.max(tonumber(ARGV[4]), new_amount)
+            local required_increment = tonumber(increment_rule or "500")
+        
+            local function publish(event_type, user_id, amount)
+                publish_event(KEYS[1], event_type, user_id, amount, ARGV[3], ARGV[6])
+            end
+        
+            -- A buy-it-now or cancellation closes bidding for good, a pause freezes it
+            if redis.call('HGET', auction_key, 'closed') == "1" then
+                return {0, "auction_closed"}
+            end
+            if redis.call('HGET', auction_key, 'frozen') == "1" then
+                return {0, "auction_paused"}
+            end
+        
+            -- Bids are never converted: they must be in the auction's currency and on its minor unit
+            if ARGV[5] ~= "" and ARGV[5] ~= auction_currency then
+                return {0, "currency_mismatch"}
+            end
+            if new_amount % minor_step ~= 0 or max_amount % minor_step ~= 0 then
+                return {0, "invalid_amount_precision"}
+            end
+        
+            -- Sealed bids are recorded per bidder (a new bid revises the old one) and
+            -- current_bid keeps the starting bid until the auction is resolved
+            local auction_type = redis.call('HGET', auction_key, 'auction_type')
+            if auction_type == "sealed_first_price" or auction_type == "sealed_second_price" then
+                if new_amount < current then
+                    publish("bid_rejected", ARGV[2], new_amount)
+                    return {0, "below_starting_bid"}
+                end
+                -- Every sealed bidder may win, so every sealed bid holds credit
+                if not has_credit(ARGV[2], new_amount) then
+                    return {0, "insufficient_credit"}
+                end
+                set_hold(ARGV[2], new_amount)
+                redis.call('ZADD', sealed_bids_key, new_amount, ARGV[2])
+                redis.call('HSET', sealed_times_key, ARGV[2], ARGV[3])
+                redis.call('HINCRBY', auction_key, 'bid_count', 1)
             
-            local event_data = KEYS[1] .. ":" .. "bid_accepted" .. ":" .. ARGV[2] .. ":" .. ARGV[1] .. ":" .. ARGV[3]
-            redis.call('PUBLISH', 'auction_events', event_data)
+                publish("sealed_bid", ARGV[2], new_amount)
+                return {1, "sealed_bid_recorded"}
+            end
+        
+            -- The first Dutch bid at or above the current price wins at the current price
+            if auction_type == "dutch" then
+                if new_amount < current then
+                    publish("bid_rejected", ARGV[2], new_amount)
+                    return {0, "below_current_price"}
+                end
+                if not has_credit(ARGV[2], current) then
+                    return {0, "insufficient_credit"}
+                end
+                set_hold(ARGV[2], current)
+                redis.call('HSET', auction_key,
+                    'winner_id', ARGV[2],
+                    'closed', 1,
+                    'last_updated', ARGV[3])
+                redis.call('HINCRBY', auction_key, 'bid_count', 1)
             
-            return {1, "success"}
-        else
-            local event_data = KEYS[1] .. ":" .. "bid_rejected" .. ":" .. ARGV[2] .. ":" .. ARGV[1] .. ":" .. ARGV[3]
-            redis.call('PUBLISH', 'auction_events', event_data)
+                publish("dutch_accepted", ARGV[2], current)
+                return {1, "dutch_accepted"}
+            end
+        
+            local buy_now_price = tonumber(redis.call('HGET', auction_key, 'buy_now_price') or "0")
+            local buy_now_until = tonumber(redis.call('HGET', auction_key, 'buy_now_until') or "0")
+            if buy_now_price > 0 and new_amount >= buy_now_price
+                and (buy_now_until == 0 or tonumber(ARGV[3]) <= buy_now_until) then
+                if not has_credit(ARGV[2], buy_now_price) then
+                    return {0, "insufficient_credit"}
+                end
+                if winner_id and winner_id ~= "" and winner_id ~= ARGV[2] then
+                    release_hold(winner_id)
+                end
+                set_hold(ARGV[2], buy_now_price)
+                redis.call('HSET', auction_key,
+                    'current_bid', string.format("%d", buy_now_price),
+                    'winner_id', ARGV[2],
+                    'closed', 1,
+                    'last_updated', ARGV[3])
+                redis.call('DEL', max_bids_key)
+                redis.call('HINCRBY', auction_key, 'bid_count', 1)
             
-            return {0, "insufficient_increment"}
+                publish("buy_now", ARGV[2], buy_now_price)
+                return {1, "buy_now"}
+            end
+        
+            -- A bid may end up leading at its ceiling, so the ceiling must be covered
+            if not has_credit(ARGV[2], max_amount) then
+                return {0, "insufficient_credit"}
+            end
+        
+            local state = english_state(current, winner_id, required_increment)
+            local accepted, reason = english_bid(state, ARGV[2], new_amount, max_amount)
+            if reason == "insufficient_increment" or reason == "max_bid_not_raised" then
+                publish("bid_rejected", ARGV[2], new_amount)
+                return {0, reason}
+            end
+            save_english(state, ARGV[3])
+        
+            -- Every bid that counted joins the ladder so a retraction can replay the others
+            redis.call('RPUSH', ladder_key, cjson.encode({
+                user_id = ARGV[2],
+                bid_id = ARGV[6],
+                amount = new_amount,
+                max_amount = max_amount,
+                placed_at = tonumber(ARGV[3]),
+            }))
+        
+            -- A raised ceiling stays secret and leaves the visible price alone
+            if reason ~= "max_bid_updated" then
+                publish("bid_accepted", state.winner, state.price)
+            end
+            return {accepted, reason}
         end
+        
+        local result = apply()
+        if ARGV[6] ~= "" then
+            redis.call('SET', bid_id_key, result[1] .. ":" .. result[2], 'EX', ARGV[7])
+        end
+        return reply(result[1], result[2], 0)
     `
 
-	result, err := r.client.Eval(ctx, luaScript, []string{auctionID},
-		fmt.Sprintf("%.2f", amount),
-		userID,
-		strconv.FormatInt(time.Now().Unix(), 10)).Result()
+	result, err := r.client.Eval(ctx, luaScript, []string{bid.AuctionID}, eventArgs(r.events, utils.GenerateID("event"),
+		cents(bid.Amount),
+		bid.UserID,
+		strconv.FormatInt(time.Now().Unix(), 10),
+		cents(bid.MaxAmount),
+		strings.ToUpper(bid.Currency),
+		bid.BidID,
+		int64(domain.BidIDTTL.Seconds()))...).Result()
 
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	resultSlice := result.([]interface{})
-	return resultSlice[0].(int64) == 1, nil
+	return &domain.BidOutcome{
+		Accepted:       resultSlice[0].(int64) == 1,
+		Reason:         resultSlice[1].(string),
+		Replayed:       resultSlice[2].(int64) == 1,
+		CurrentPrice:   domain.Money(resultSlice[3].(int64)),
+		Leading:        resultSlice[4].(int64) == 1,
+		NextMinimumBid: domain.Money(resultSlice[5].(int64)),
+	}, nil
+}
+
+// GetBidOutcome only knows the stored accepted flag and reason, not the live state
+func (r *BidCacheImpl) GetBidOutcome(ctx context.Context, auctionID, userID, bidID string) (*domain.BidOutcome, error) {
+	key := fmt.Sprintf("auction:%s:bid_ids:%s:%s", auctionID, userID, bidID)
+
+	seen, err := r.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	accepted, reason, _ := strings.Cut(seen, ":")
+	return &domain.BidOutcome{Accepted: accepted == "1", Reason: reason, Replayed: true}, nil
 }
 
 func (r *BidCacheImpl) GetCurrentBid(ctx context.Context, auctionID string) (*domain.LocalAuctionCache, error) {
 	key := fmt.Sprintf("auction:%s", auctionID)
 
-	result, err := r.client.HMGet(ctx, key, "current_bid", "winner_id", "increment_rule").Result()
-	if err != nil {
+	// Read together with the sequence, so the state is exactly that of its last event
+	var state *redis.SliceCmd
+	var sequence *redis.StringCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		state = pipe.HMGet(ctx, key, "current_bid", "winner_id", "increment_rule", "reserve_price",
+			"end_time", "bid_count", "sale_id", "currency", "auction_type")
+		sequence = pipe.Get(ctx, key+":event_seq")
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
 		return nil, err
 	}
+	result := state.Val()
+	lastSequence, _ := sequence.Int64()
 
-	currentBid := 0.0
+	var currentBid, reservePrice domain.Money
 	winnerID := ""
-	incrementRule := 5.0
+	incrementRule := domain.Money(500)
+	bidCount := 0
+	var endTime time.Time
 
 	if result[0] != nil {
-		currentBid, _ = strconv.ParseFloat(result[0].(string), 64)
+		currentBid = parseCents(result[0].(string))
 	}
 	if result[1] != nil {
 		winnerID = result[1].(string)
 	}
 	if result[2] != nil {
-		incrementRule, _ = strconv.ParseFloat(result[2].(string), 64)
+		incrementRule = parseCents(result[2].(string))
+	}
+	if result[3] != nil {
+		reservePrice = parseCents(result[3].(string))
+	}
+	if result[4] != nil {
+		endUnix, _ := strconv.ParseInt(result[4].(string), 10, 64)
+		endTime = time.Unix(endUnix, 0)
 	}
+	if result[5] != nil {
+		bidCount, _ = strconv.Atoi(result[5].(string))
+	}
+	saleID, _ := result[6].(string)
+	currency, _ := result[7].(string)
+	auctionType, _ := result[8].(string)
 
 	return &domain.LocalAuctionCache{
 		AuctionID:     auctionID,
+		AuctionType:   domain.AuctionType(auctionType),
 		CurrentBid:    currentBid,
+		Currency:      currency,
 		WinnerID:      winnerID,
 		IncrementRule: incrementRule,
+		ReservePrice:  reservePrice,
+		EndTime:       endTime,
+		BidCount:      bidCount,
+		SaleID:        saleID,
+		Sequence:      lastSequence,
 		LastUpdated:   time.Now(),
 	}, nil
 }
 
-func (r *BidCacheImpl) SetBiddingIncrementRule(ctx context.Context, auctionID string, rule float64) error {
+func (r *BidCacheImpl) SetBiddingIncrementRule(ctx context.Context, auctionID string, rule domain.Money) error {
+	key := fmt.Sprintf("auction:%s", auctionID)
+	return r.client.HSet(ctx, key, "increment_rule", cents(rule)).Err()
+}
+
+func (r *BidCacheImpl) SetEndTime(ctx context.Context, auctionID string, endTime time.Time) error {
+	key := fmt.Sprintf("auction:%s", auctionID)
+	return r.client.HSet(ctx, key, "end_time", endTime.Unix()).Err()
+}
+
+func (r *BidCacheImpl) FreezeBidding(ctx context.Context, auctionID string, frozen bool) error {
+	key := fmt.Sprintf("auction:%s", auctionID)
+
+	value := 0
+	if frozen {
+		value = 1
+	}
+	return r.client.HSet(ctx, key, "frozen", value).Err()
+}
+
+func (r *BidCacheImpl) CloseBidding(ctx context.Context, auctionID string) (bool, error) {
+	// Atomic, so of a buy-now and an end closing together only one sees bidding open
+	luaScript := `
+        local was_open = redis.call('HGET', KEYS[1], 'closed') ~= "1"
+        redis.call('HSET', KEYS[1], 'closed', 1)
+        if was_open then
+            return 1
+        end
+        return 0
+    `
+
+	key := fmt.Sprintf("auction:%s", auctionID)
+	result, err := r.client.Eval(ctx, luaScript, []string{key}).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return result.(int64) == 1, nil
+}
+
+func (r *BidCacheImpl) ReopenBidding(ctx context.Context, auctionID string) error {
 	key := fmt.Sprintf("auction:%s", auctionID)
-	return r.client.HSet(ctx, key, "increment_rule", fmt.Sprintf("%.2f", rule)).Err()
+	return r.client.HSet(ctx, key, "closed", 0).Err()
+}
+
+func (r *BidCacheImpl) GetSealedBids(ctx context.Context, auctionID string) ([]*domain.SealedBid, error) {
+	key := fmt.Sprintf("auction:%s:sealed_bids", auctionID)
+
+	entries, err := r.client.ZRevRangeWithScores(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	times, err := r.client.HGetAll(ctx, fmt.Sprintf("auction:%s:sealed_bid_times", auctionID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	bids := make([]*domain.SealedBid, 0, len(entries))
+	for _, entry := range entries {
+		userID := entry.Member.(string)
+		placedAt, _ := strconv.ParseInt(times[userID], 10, 64)
+		bids = append(bids, &domain.SealedBid{
+			UserID:   userID,
+			Amount:   domain.Money(entry.Score),
+			PlacedAt: time.Unix(placedAt, 0),
+		})
+	}
+
+	// Equal amounts go to whoever bid first
+	sort.SliceStable(bids, func(i, j int) bool {
+		if bids[i].Amount != bids[j].Amount {
+			return bids[i].Amount > bids[j].Amount
+		}
+		return bids[i].PlacedAt.Before(bids[j].PlacedAt)
+	})
+
+	return bids, nil
+}
+
+func (r *BidCacheImpl) SetWinningBid(ctx context.Context, auctionID, userID string, amount domain.Money) error {
+	key := fmt.Sprintf("auction:%s", auctionID)
+	return r.client.HSet(ctx, key,
+		"current_bid", cents(amount),
+		"winner_id", userID,
+		"closed", 1,
+		"last_updated", time.Now().Unix(),
+	).Err()
+}
+
+func (r *BidCacheImpl) DropPrice(ctx context.Context, auctionID string, step, floor domain.Money) (domain.Money, bool, error) {
+	// Runs atomically with AtomicBidUpdate so a drop never lands after an acceptance
+	luaScript := `
+        local auction_key = "auction:" .. KEYS[1]
+` + eventLua + `
+        local current_amount = redis.call('HGET', auction_key, 'current_bid')
+        
+        if current_amount == false then
+            return {0, "0"}
+        end
+        
+        if redis.call('HGET', auction_key, 'closed') == "1" or redis.call('HGET', auction_key, 'frozen') == "1" then
+            return {0, current_amount}
+        end
+        
+        local current = tonumber(current_amount)
+        local next_price = math.max(current - tonumber(ARGV[1]), tonumber(ARGV[2]))
+        if next_price >= current then
+            return {0, current_amount}
+        end
+        
+        local price = string.format("%d", next_price)
+        redis.call('HSET', auction_key,
+            'current_bid', price,
+            'last_updated', ARGV[3])
+        
+        publish_event(KEYS[1], "price_dropped", "", next_price, ARGV[3])
+        
+        return {1, price}
+    `
+
+	result, err := r.client.Eval(ctx, luaScript, []string{auctionID}, eventArgs(r.events, utils.GenerateID("event"),
+		cents(step),
+		cents(floor),
+		strconv.FormatInt(time.Now().Unix(), 10))...).Result()
+	if err != nil {
+		return 0, false, err
+	}
+
+	resultSlice := result.([]interface{})
+	price, err := strconv.ParseInt(resultSlice[1].(string), 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return domain.Money(price), resultSlice[0].(int64) == 1, nil
+}
+
+// ladderEntry is a ladder bid as the bid script encodes it
+type ladderEntry struct {
+	UserID    string `json:"user_id"`
+	BidID     string `json:"bid_id"`
+	Amount    int64  `json:"amount"`
+	MaxAmount int64  `json:"max_amount"`
+	PlacedAt  int64  `json:"placed_at"`
+}
+
+func (r *BidCacheImpl) GetBidLadder(ctx context.Context, auctionID string) ([]*domain.LadderBid, error) {
+	key := fmt.Sprintf("auction:%s:ladder", auctionID)
+
+	entries, err := r.client.LRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	ladder := make([]*domain.LadderBid, 0, len(entries))
+	for _, entry := range entries {
+		var bid ladderEntry
+		if err := json.Unmarshal([]byte(entry), &bid); err != nil {
+			return nil, fmt.Errorf("ladder entry %q: %w", entry, err)
+		}
+		ladder = append(ladder, &domain.LadderBid{
+			UserID:    bid.UserID,
+			BidID:     bid.BidID,
+			Amount:    domain.Money(bid.Amount),
+			MaxAmount: domain.Money(bid.MaxAmount),
+			PlacedAt:  time.Unix(bid.PlacedAt, 0),
+		})
+	}
+
+	return ladder, nil
+}
+
+func (r *BidCacheImpl) RetractBid(ctx context.Context, retraction *domain.BidRetraction,
+	startBid, startIncrement domain.Money) (domain.Money, string, error) {
+	// Runs atomically with AtomicBidUpdate, so no bid lands between the replay and its result
+	luaScript := `
+        local auction_key = "auction:" .. KEYS[1]
+` + eventLua + biddingLua + `
+        if redis.call('HGET', auction_key, 'current_bid') == false then
+            return {0, "auction_not_found", "0", ""}
+        end
+        if redis.call('HGET', auction_key, 'closed') == "1" then
+            return {0, "auction_closed", "0", ""}
+        end
+        
+        -- Like BidRetraction.Matches: the bidder's latest bid with the bid ID or, without one, at the amount
+        local entries = redis.call('LRANGE', ladder_key, 0, -1)
+        local target = 0
+        for i = #entries, 1, -1 do
+            local bid = cjson.decode(entries[i])
+            if bid.user_id == ARGV[1] and ((ARGV[2] ~= "" and bid.bid_id == ARGV[2])
+                or (ARGV[2] == "" and bid.amount == tonumber(ARGV[3]))) then
+                target = i
+                break
+            end
+        end
+        if target == 0 then
+            return {0, "bid_not_found", "0", ""}
+        end
+        local retracted = cjson.decode(entries[target])
+        
+        -- Replay the other bids from the starting bid. A bid that no longer clears
+        -- the price it now meets drops off the ladder.
+        local state = {
+            price = tonumber(ARGV[4]),
+            winner = "",
+            increment = tonumber(ARGV[5]),
+            bid_count = 0,
+            ceilings = {},
+        }
+        redis.call('DEL', ladder_key)
+        for i, entry in ipairs(entries) do
+            if i ~= target then
+                local bid = cjson.decode(entry)
+                local _, reason = english_bid(state, bid.user_id, bid.amount, bid.max_amount)
+                if reason ~= "insufficient_increment" and reason ~= "max_bid_not_raised" then
+                    redis.call('RPUSH', ladder_key, entry)
+                end
+            end
+        end
+        save_english(state, ARGV[6])
+        
+        publish_event(KEYS[1], "bid_retracted", state.winner, state.price, ARGV[6], retracted.bid_id)
+        
+        return {1, "retracted", string.format("%d", state.price), state.winner}
+    `
+
+	result, err := r.client.Eval(ctx, luaScript, []string{retraction.AuctionID}, eventArgs(r.events, utils.GenerateID("event"),
+		retraction.UserID,
+		retraction.BidID,
+		cents(retraction.Amount),
+		cents(startBid),
+		cents(startIncrement),
+		strconv.FormatInt(time.Now().Unix(), 10))...).Result()
+	if err != nil {
+		return 0, "", err
+	}
+
+	resultSlice := result.([]interface{})
+	switch reason := resultSlice[1].(string); reason {
+	case "retracted":
+		return parseCents(resultSlice[2].(string)), resultSlice[3].(string), nil
+	case "bid_not_found":
+		return 0, "", domain.ErrBidNotFound
+	case "auction_not_found":
+		return 0, "", domain.ErrAuctionNotFound
+	default:
+		return 0, "", fmt.Errorf("%w: %s", domain.ErrRetractionNotAllowed, reason)
+	}
+}
+
+func cents(m domain.Money) string {
+	return strconv.FormatInt(int64(m), 10)
+}
+
+func parseCents(s string) domain.Money {
+	v, _ := strconv.ParseInt(s, 10, 64)
+	return domain.Money(v)
 }
@@ -0,0 +1,121 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"auction-system/internal/domain"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+func newTestBidCache(t *testing.T) *BidCacheImpl {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return NewBidCache(client, EventSink{Producer: "test"})
+}
+
+func TestEnglishBid(t *testing.T) {
+	type bid struct {
+		userID     string
+		amount     domain.Money
+		maxAmount  domain.Money
+		wantReason string
+	}
+
+	// Every auction starts at 100.00 with a fixed increment of 5.00
+	tests := []struct {
+		name       string
+		bids       []bid
+		wantPrice  domain.Money
+		wantWinner string
+	}{
+		{
+			name: "ties go to the earlier bidder",
+			bids: []bid{
+				{userID: "alice", amount: 10500, maxAmount: 20000, wantReason: "success"},
+				{userID: "bob", amount: 20000, maxAmount: 20000, wantReason: "outbid_by_proxy"},
+			},
+			wantPrice:  20000,
+			wantWinner: "alice",
+		},
+		{
+			name: "leader raises their own ceiling",
+			bids: []bid{
+				{userID: "alice", amount: 10500, maxAmount: 15000, wantReason: "success"},
+				{userID: "alice", amount: 10500, maxAmount: 30000, wantReason: "max_bid_updated"},
+				{userID: "alice", amount: 10500, maxAmount: 25000, wantReason: "max_bid_not_raised"},
+				{userID: "bob", amount: 25000, maxAmount: 25000, wantReason: "outbid_by_proxy"},
+			},
+			wantPrice:  25500,
+			wantWinner: "alice",
+		},
+		{
+			name: "challenger wins at the loser's max plus one increment",
+			bids: []bid{
+				{userID: "alice", amount: 10500, maxAmount: 15000, wantReason: "success"},
+				{userID: "bob", amount: 12000, maxAmount: 30000, wantReason: "success"},
+			},
+			wantPrice:  15500,
+			wantWinner: "bob",
+		},
+		{
+			name: "leader's proxy holds at the loser's max plus one increment",
+			bids: []bid{
+				{userID: "alice", amount: 10500, maxAmount: 30000, wantReason: "success"},
+				{userID: "bob", amount: 16000, maxAmount: 16000, wantReason: "outbid_by_proxy"},
+			},
+			wantPrice:  16500,
+			wantWinner: "alice",
+		},
+		{
+			name: "bid below one increment over the price",
+			bids: []bid{
+				{userID: "alice", amount: 10400, maxAmount: 10400, wantReason: "insufficient_increment"},
+			},
+			wantPrice: 10000,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		cache := newTestBidCache(t)
+		auction := &domain.Auction{
+			ID:       "auction_1",
+			Type:     domain.AuctionEnglish,
+			StartBid: 10000,
+			Currency: "USD",
+			EndTime:  time.Now().Add(time.Hour),
+		}
+		if err := cache.InitializeBidding(ctx, auction, 500); err != nil {
+			t.Fatalf("%s: InitializeBidding: %v", tt.name, err)
+		}
+
+		for i, b := range tt.bids {
+			outcome, err := cache.AtomicBidUpdate(ctx, &domain.BidRequest{
+				AuctionID: auction.ID,
+				UserID:    b.userID,
+				Amount:    b.amount,
+				MaxAmount: b.maxAmount,
+			})
+			if err != nil {
+				t.Fatalf("%s: bid %d: %v", tt.name, i, err)
+			}
+			if outcome.Reason != b.wantReason {
+				t.Errorf("%s: bid %d by %s: got %s, want %s", tt.name, i, b.userID, outcome.Reason, b.wantReason)
+			}
+		}
+
+		state, err := cache.GetCurrentBid(ctx, auction.ID)
+		if err != nil {
+			t.Fatalf("%s: GetCurrentBid: %v", tt.name, err)
+		}
+		if state.CurrentBid != tt.wantPrice || state.WinnerID != tt.wantWinner {
+			t.Errorf("%s: got %s leading at %v, want %s at %v", tt.name,
+				state.WinnerID, state.CurrentBid, tt.wantWinner, tt.wantPrice)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"auction-system/internal/domain"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// CreditCacheImpl shares its keys with the bid scripts in bid_cache.go:
+// bidder:<user>:credit_limits maps currencies to limits, bidder:<user>:holds:<currency>
+// maps auction IDs to holds and auction:<id>:holds maps bidders to holds. All
+// amounts are in cents.
+type CreditCacheImpl struct {
+	client *redis.Client
+}
+
+func NewCreditCache(client *redis.Client) *CreditCacheImpl {
+	return &CreditCacheImpl{client: client}
+}
+
+func (r *CreditCacheImpl) SetCreditLimit(ctx context.Context, limit *domain.CreditLimit) error {
+	key := fmt.Sprintf("bidder:%s:credit_limits", limit.UserID)
+	return r.client.HSet(ctx, key, limit.Currency, cents(limit.Limit)).Err()
+}
+
+func (r *CreditCacheImpl) GetCreditAccount(ctx context.Context, userID, currency string) (*domain.CreditAccount, error) {
+	account := &domain.CreditAccount{
+		UserID:   userID,
+		Currency: currency,
+		Holds:    make(map[string]domain.Money),
+	}
+
+	limit, err := r.client.HGet(ctx, fmt.Sprintf("bidder:%s:credit_limits", userID), currency).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		account.Unlimited = true
+	case err != nil:
+		return nil, err
+	default:
+		account.Limit = parseCents(limit)
+	}
+
+	holds, err := r.client.HGetAll(ctx, fmt.Sprintf("bidder:%s:holds:%s", userID, currency)).Result()
+	if err != nil {
+		return nil, err
+	}
+	for auctionID, amount := range holds {
+		account.Holds[auctionID] = parseCents(amount)
+	}
+
+	return account, nil
+}
+
+func (r *CreditCacheImpl) SetHold(ctx context.Context, auctionID, userID, currency string, amount domain.Money) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, fmt.Sprintf("bidder:%s:holds:%s", userID, currency), auctionID, cents(amount))
+		pipe.HSet(ctx, fmt.Sprintf("auction:%s:holds", auctionID), userID, cents(amount))
+		return nil
+	})
+	return err
+}
+
+func (r *CreditCacheImpl) ReleaseHolds(ctx context.Context, auctionID, currency, exceptUserID string) error {
+	// Atomic with the bid scripts, so a hold placed by a late bid is not half released
+	luaScript := `
+        local holders = redis.call('HKEYS', KEYS[1])
+        for _, user_id in ipairs(holders) do
+            if user_id ~= ARGV[3] then
+                redis.call('HDEL', "bidder:" .. user_id .. ":holds:" .. ARGV[2], ARGV[1])
+                redis.call('HDEL', KEYS[1], user_id)
+            end
+        end
+        return #holders
+    `
+
+	return r.client.Eval(ctx, luaScript, []string{fmt.Sprintf("auction:%s:holds", auctionID)},
+		auctionID, currency, exceptUserID).Err()
+}
@@ -2,24 +2,136 @@ package redis
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	"auction-system/internal/domain"
+	"auction-system/pkg/utils"
 
 	"github.com/go-redis/redis/v8"
 )
 
+// eventStream is the Redis stream events are added to when the streams
+// transport is configured. eventLua names it too.
+const eventStream = "auction_events:stream"
+
+// EventSink says how an instance publishes events: as Producer, either on the
+// auction_events pub/sub channel or, with Streams set, to eventStream capped at
+// about StreamMaxLen entries
+type EventSink struct {
+	Producer     string
+	Streams      bool
+	StreamMaxLen int64
+}
+
+// eventLua defines publish_event, which builds the domain.EventEnvelope of every
+// event published, by EventPublisherImpl as well as the bid scripts. It takes
+// the event ID and the EventSink from the last four ARGV, which eventArgs fills
+// in, and amounts in cents. Sequenced events get the next number of
+// auction:<id>:event_seq in the same step as they are published, so an
+// auction's events are numbered in the order they went out.
+const eventLua = `
+        local event_id, event_producer = ARGV[#ARGV - 3], ARGV[#ARGV - 2]
+        local event_streams, event_stream_max_len = ARGV[#ARGV - 1] == "1", ARGV[#ARGV]
+        
+        local function format_money(amount)
+            return string.format("%d.%02d", math.floor(amount / 100), amount % 100)
+        end
+        
+        -- Like domain.BidEventType.Sequenced
+        local unsequenced = {bid_rejected = true, sealed_bid = true, rate_limited = true}
+        
+        local function publish_event(auction_id, event_type, user_id, amount, timestamp, bid_id, end_time)
+            local sequence = 0
+            if not unsequenced[event_type] then
+                sequence = redis.call('INCR', "auction:" .. auction_id .. ":event_seq")
+            end
+            local event_data = cjson.encode({
+                event_id = event_id,
+                schema_version = 1,
+                type = event_type,
+                auction_id = auction_id,
+                sequence = sequence,
+                producer = event_producer,
+                payload = {
+                    user_id = user_id,
+                    amount = format_money(amount),
+                    timestamp = tonumber(timestamp),
+                    bid_id = bid_id or "",
+                    end_time = end_time,
+                },
+            })
+            if event_streams then
+                redis.call('XADD', 'auction_events:stream', 'MAXLEN', '~', event_stream_max_len, '*', 'event', event_data)
+            else
+                redis.call('PUBLISH', 'auction_events', event_data)
+            end
+            return sequence
+        end
+`
+
+// eventArgs appends the ARGV eventLua expects. A script publishes at most one
+// event per run, so one event ID is enough.
+func eventArgs(sink EventSink, eventID string, args ...interface{}) []interface{} {
+	streams := 0
+	if sink.Streams {
+		streams = 1
+	}
+	return append(args, eventID, sink.Producer, streams, sink.StreamMaxLen)
+}
+
+// publishedEventTTL is how long PublishBiddingEvent remembers the IDs of the
+// events it published
+const publishedEventTTL = 24 * time.Hour
+
 type EventPublisherImpl struct {
 	client *redis.Client
+	sink   EventSink
 }
 
-func NewEventPublisher(client *redis.Client) *EventPublisherImpl {
-	return &EventPublisherImpl{client: client}
+func NewEventPublisher(client *redis.Client, sink EventSink) *EventPublisherImpl {
+	return &EventPublisherImpl{client: client, sink: sink}
 }
 
+// PublishBiddingEvent keeps the event's ID if it has one and sets its sequence
+// number if it is sequenced. An event whose ID was already published is
+// dropped, so an event can be safely published again after a failure.
 func (r *EventPublisherImpl) PublishBiddingEvent(ctx context.Context, event *domain.BidEvent) error {
-	eventData := fmt.Sprintf("%s:%s:%s:%.2f:%d",
-		event.AuctionID, event.Type, event.UserID, event.Amount, event.Timestamp.Unix())
+	luaScript := eventLua + `
+        if not redis.call('SET', "event_published:" .. event_id, "1", 'NX', 'EX', ARGV[6]) then
+            return -1
+        end
+        local end_time = tonumber(ARGV[7])
+        if end_time == 0 then
+            end_time = nil
+        end
+        return publish_event(KEYS[1], ARGV[1], ARGV[2], tonumber(ARGV[3]), ARGV[4], ARGV[5], end_time)
+    `
+
+	eventID := event.EventID
+	if eventID == "" {
+		eventID = utils.GenerateID("event")
+	}
+
+	endTime := int64(0)
+	if !event.EndTime.IsZero() {
+		endTime = event.EndTime.Unix()
+	}
+
+	sequence, err := r.client.Eval(ctx, luaScript, []string{event.AuctionID}, eventArgs(r.sink, eventID,
+		string(event.Type),
+		event.UserID,
+		cents(event.Amount),
+		event.Timestamp.Unix(),
+		event.BidID,
+		int64(publishedEventTTL.Seconds()),
+		endTime)...).Int64()
+	if err != nil {
+		return err
+	}
 
-	return r.client.Publish(ctx, "auction_events", eventData).Err()
+	event.EventID = eventID
+	if sequence >= 0 {
+		event.Sequence = sequence
+	}
+	return nil
 }
@@ -0,0 +1,183 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/pkg/logger"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	streamReadCount = 100
+	streamBlock     = 2 * time.Second
+)
+
+// StreamEventSubscriber reads eventStream through a consumer group. Instances
+// that share a group split the events between them, while an instance with a
+// group of its own sees all of them. Events are acknowledged once handled or
+// dead-lettered, and any a crashed consumer left pending for claimIdle are
+// claimed and handled again, so delivery is at least once. Dead letters belong
+// to the group, so a replay goes to whichever of its consumers takes it first.
+type StreamEventSubscriber struct {
+	client    *redis.Client
+	group     string
+	consumer  string
+	claimIdle time.Duration
+	delivery  eventDelivery
+	log       logger.Logger
+
+	// A group created by this subscriber starts at startedAt. lastID is the last
+	// event read, only touched by the subscribe loop.
+	startedAt time.Time
+	lastID    string
+}
+
+func NewStreamEventSubscriber(client *redis.Client, group, consumer string, claimIdle time.Duration,
+	policy DeliveryPolicy, log logger.Logger) *StreamEventSubscriber {
+	return &StreamEventSubscriber{
+		client:    client,
+		group:     group,
+		consumer:  consumer,
+		claimIdle: claimIdle,
+		delivery:  eventDelivery{consumer: group, policy: policy, log: log},
+		log:       log,
+		startedAt: time.Now(),
+	}
+}
+
+func (s *StreamEventSubscriber) SubscribeToBidEvents(ctx context.Context, handler domain.EventHandler) error {
+	if err := s.createGroup(ctx, fmt.Sprintf("%d-0", s.startedAt.UnixMilli())); err != nil {
+		return err
+	}
+
+	s.log.Info("Subscribed to auction event stream", "group", s.group, "consumer", s.consumer)
+
+	var lastClaim, lastReplay time.Time
+	for {
+		if ctx.Err() != nil {
+			s.log.Info("Event subscriber stopped")
+			return ctx.Err()
+		}
+
+		if time.Since(lastClaim) >= s.claimIdle {
+			s.claimPending(ctx, handler)
+			lastClaim = time.Now()
+		}
+		if time.Since(lastReplay) >= replayInterval {
+			s.delivery.replay(ctx, handler)
+			lastReplay = time.Now()
+		}
+
+		streams, err := s.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    s.group,
+			Consumer: s.consumer,
+			Streams:  []string{eventStream, ">"},
+			Count:    streamReadCount,
+			Block:    streamBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				continue
+			}
+			s.log.Error("Failed to read event stream", "group", s.group, "error", err)
+			// The stream or the group was deleted under us; carry on after the last event read
+			if strings.HasPrefix(err.Error(), "NOGROUP") {
+				start := s.lastID
+				if start == "" {
+					start = fmt.Sprintf("%d-0", s.startedAt.UnixMilli())
+				}
+				if err := s.createGroup(ctx, start); err != nil {
+					s.log.Error("Failed to recreate consumer group", "group", s.group, "error", err)
+				}
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				s.handle(ctx, msg, handler)
+				s.lastID = msg.ID
+			}
+		}
+	}
+}
+
+// createGroup starts a new group after the stream ID start. A new instance
+// starts from when it was created rather than from the end of the stream, so
+// it does not miss the events published while it was starting up, and does not
+// replay older history. An existing group carries on where it left off.
+func (s *StreamEventSubscriber) createGroup(ctx context.Context, start string) error {
+	err := s.client.XGroupCreateMkStream(ctx, eventStream, s.group, start).Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	return nil
+}
+
+// claimPending takes over the group's events that have been pending for claimIdle
+func (s *StreamEventSubscriber) claimPending(ctx context.Context, handler domain.EventHandler) {
+	pending, err := s.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: eventStream,
+		Group:  s.group,
+		Idle:   s.claimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  streamReadCount,
+	}).Result()
+	if err != nil {
+		s.log.Error("Failed to list pending events", "group", s.group, "error", err)
+		return
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(pending))
+	for _, entry := range pending {
+		ids = append(ids, entry.ID)
+	}
+
+	// Another consumer may claim some of them first; XCLAIM only returns ours
+	messages, err := s.client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   eventStream,
+		Group:    s.group,
+		Consumer: s.consumer,
+		MinIdle:  s.claimIdle,
+		Messages: ids,
+	}).Result()
+	if err != nil {
+		s.log.Error("Failed to claim pending events", "group", s.group, "error", err)
+		return
+	}
+
+	s.log.Info("Claimed pending events", "group", s.group, "count", len(messages))
+	for _, msg := range messages {
+		s.handle(ctx, msg, handler)
+	}
+}
+
+// handle acknowledges an event once it is handled or dead-lettered. One that
+// is neither stays pending, to be claimed again.
+func (s *StreamEventSubscriber) handle(ctx context.Context, msg redis.XMessage, handler domain.EventHandler) {
+	payload, _ := msg.Values["event"].(string)
+	if _, done := s.delivery.deliver(ctx, payload, handler); !done {
+		return
+	}
+
+	if err := s.client.XAck(ctx, eventStream, s.group, msg.ID).Err(); err != nil {
+		s.log.Error("Failed to acknowledge event", "id", msg.ID, "error", err)
+	}
+}
@@ -0,0 +1,180 @@
+package redis
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"auction-system/internal/domain"
+)
+
+func TestParseEventData(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    domain.BidEvent
+	}{
+		{
+			name: "envelope",
+			payload: `{"event_id":"event_1","schema_version":1,"type":"bid_accepted","auction_id":"auction_1",` +
+				`"sequence":42,"producer":"bidding-service-1",` +
+				`"payload":{"user_id":"user_1","amount":"150.00","timestamp":1760000000,"bid_id":"b-1"}}`,
+			want: domain.BidEvent{
+				EventID:   "event_1",
+				Sequence:  42,
+				Type:      domain.BidAccepted,
+				AuctionID: "auction_1",
+				UserID:    "user_1",
+				Amount:    15000,
+				Timestamp: time.Unix(1760000000, 0),
+				BidID:     "b-1",
+			},
+		},
+		{
+			name: "envelope with unknown fields",
+			payload: `{"event_id":"event_2","schema_version":1,"type":"auction_ended","auction_id":"auction_1",` +
+				`"sequence":0,"producer":"p","region":"eu","payload":{"user_id":"","amount":"0.00","timestamp":1,"bid_id":"","note":"x"}}`,
+			want: domain.BidEvent{
+				EventID:   "event_2",
+				Type:      domain.AuctionEndedBidRejected,
+				AuctionID: "auction_1",
+				Timestamp: time.Unix(1, 0),
+			},
+		},
+		{
+			name: "envelope with end time",
+			payload: `{"event_id":"event_3","schema_version":1,"type":"auction_extended","auction_id":"auction_1",` +
+				`"sequence":43,"producer":"p","payload":{"user_id":"","amount":"0.00","timestamp":1760000000,"bid_id":"","end_time":1760000120}}`,
+			want: domain.BidEvent{
+				EventID:   "event_3",
+				Sequence:  43,
+				Type:      domain.AuctionExtended,
+				AuctionID: "auction_1",
+				Timestamp: time.Unix(1760000000, 0),
+				EndTime:   time.Unix(1760000120, 0),
+			},
+		},
+		{
+			name:    "legacy",
+			payload: "auction_1:bid_accepted:user_1:150.00:1760000000",
+			want: domain.BidEvent{
+				Type:      domain.BidAccepted,
+				AuctionID: "auction_1",
+				UserID:    "user_1",
+				Amount:    15000,
+				Timestamp: time.Unix(1760000000, 0),
+			},
+		},
+		{
+			name:    "legacy with bid ID",
+			payload: "auction_1:bid_accepted:user_1:150.00:1760000000:b-1",
+			want: domain.BidEvent{
+				Type:      domain.BidAccepted,
+				AuctionID: "auction_1",
+				UserID:    "user_1",
+				Amount:    15000,
+				Timestamp: time.Unix(1760000000, 0),
+				BidID:     "b-1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := parseEventData(tt.payload)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if *got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.name, *got, tt.want)
+		}
+	}
+}
+
+func TestParseEventDataRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr error
+	}{
+		{
+			name:    "newer schema version",
+			payload: `{"event_id":"e","schema_version":2,"type":"bid_accepted","auction_id":"a","payload":{}}`,
+			wantErr: domain.ErrUnsupportedEventSchema,
+		},
+		{
+			name:    "missing schema version",
+			payload: `{"event_id":"e","type":"bid_accepted","auction_id":"a","payload":{}}`,
+			wantErr: domain.ErrUnsupportedEventSchema,
+		},
+		{name: "no auction ID", payload: `{"event_id":"e","schema_version":1,"type":"bid_accepted","payload":{}}`},
+		{name: "no type", payload: `{"event_id":"e","schema_version":1,"auction_id":"a","payload":{}}`},
+		{name: "invalid JSON", payload: `{"event_id":`},
+		{name: "invalid amount", payload: `{"schema_version":1,"type":"t","auction_id":"a","payload":{"amount":"1.001"}}`},
+		{name: "legacy too short", payload: "auction_1:bid_accepted:user_1:150.00"},
+		{name: "legacy invalid amount", payload: "auction_1:bid_accepted:user_1:abc:1760000000"},
+		{name: "legacy invalid timestamp", payload: "auction_1:bid_accepted:user_1:150.00:soon"},
+	}
+
+	for _, tt := range tests {
+		got, err := parseEventData(tt.payload)
+		if err == nil {
+			t.Errorf("%s: got %+v, want an error", tt.name, got)
+			continue
+		}
+		if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCheckSequence(t *testing.T) {
+	event := func(auctionID string, eventType domain.BidEventType, sequence int64) *domain.BidEvent {
+		return &domain.BidEvent{Type: eventType, AuctionID: auctionID, Sequence: sequence}
+	}
+
+	tests := []struct {
+		event      *domain.BidEvent
+		wantMissed bool
+	}{
+		// The first event seen only starts tracking, wherever the sequence is
+		{event: event("a", domain.BidAccepted, 5)},
+		{event: event("a", domain.BidAccepted, 6)},
+		// A jump of any size is one gap
+		{event: event("a", domain.BidAccepted, 9), wantMissed: true},
+		{event: event("a", domain.BidAccepted, 10)},
+		// Redelivered or late events are not gaps and do not move the sequence back
+		{event: event("a", domain.BidAccepted, 8)},
+		{event: event("a", domain.BidAccepted, 10)},
+		{event: event("a", domain.BidAccepted, 11)},
+		// Unsequenced events are ignored
+		{event: event("a", domain.BidRejected, 0)},
+		// Auctions are tracked separately
+		{event: event("b", domain.BidAccepted, 1)},
+		{event: event("b", domain.BidAccepted, 3), wantMissed: true},
+		{event: event("a", domain.BidAccepted, 12)},
+		// An ended auction is no longer tracked, even if its end came after a gap
+		{event: event("a", domain.AuctionEndedBidRejected, 20)},
+		{event: event("a", domain.BidAccepted, 25)},
+		{event: event("b", domain.AuctionCancelledEvent, 4)},
+	}
+
+	subscriber := &RedisEventSubscriber{sequences: make(map[string]int64)}
+	for i, tt := range tests {
+		missed := subscriber.checkSequence(tt.event)
+		if !tt.wantMissed {
+			if missed != nil {
+				t.Errorf("event %d (%s #%d): got %+v, want no missed events", i, tt.event.AuctionID, tt.event.Sequence, missed)
+			}
+			continue
+		}
+
+		if missed == nil {
+			t.Errorf("event %d (%s #%d): want missed events", i, tt.event.AuctionID, tt.event.Sequence)
+			continue
+		}
+		if missed.Type != domain.EventsMissed || missed.AuctionID != tt.event.AuctionID || missed.Sequence != tt.event.Sequence {
+			t.Errorf("event %d: got %+v, want events_missed for %s at #%d", i, missed, tt.event.AuctionID, tt.event.Sequence)
+		}
+	}
+}
@@ -44,3 +44,27 @@ func (r *StateCacheImpl) GetAuctionStatus(ctx context.Context,
 
 	return domain.AuctionStatus(status), nil
 }
+
+func (r *StateCacheImpl) CompareAndSetStatus(ctx context.Context, auctionID string,
+	from, to domain.AuctionStatus) (bool, error) {
+	// A missing key reads as pending, matching GetAuctionStatus
+	luaScript := `
+        local current = redis.call('GET', KEYS[1])
+        if current == false then
+            current = "0"
+        end
+        if current ~= ARGV[1] then
+            return 0
+        end
+        redis.call('SET', KEYS[1], ARGV[2])
+        return 1
+    `
+
+	key := fmt.Sprintf("auction:%s:status", auctionID)
+	result, err := r.client.Eval(ctx, luaScript, []string{key}, int(from), int(to)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return result.(int64) == 1, nil
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"auction-system/internal/domain/repositories"
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -11,10 +13,32 @@ import (
 	"auction-system/pkg/utils"
 )
 
+// AuctionDetails combines the stored auction with its live bidding state
+type AuctionDetails struct {
+	Auction        *domain.Auction
+	Item           *domain.ItemSummary // nil when no item is linked
+	Status         domain.AuctionStatus
+	CurrentPrice   domain.Money
+	LeaderID       string
+	NextMinimumBid domain.Money
+	BidCount       int
+	TimeRemaining  time.Duration
+}
+
+// AuctionPage is one page of an auction listing
+type AuctionPage struct {
+	Auctions []*domain.Auction
+	Items    map[string]*domain.ItemSummary // keyed by item ID
+	Next     *domain.AuctionCursor          // nil on the last page
+}
+
 type AuctionManager struct {
 	auctionRepo    repositories.AuctionRepository
+	itemRepo       repositories.ItemRepository
+	outboxRepo     repositories.OutboxRepository
 	stateCache     domain.AuctionStateCache
 	bidCache       domain.BidCache
+	creditCache    domain.CreditCache
 	eventPub       domain.EventPublisher
 	scheduler      domain.AuctionScheduler
 	leaderElection domain.LeaderElection
@@ -27,8 +51,11 @@ type AuctionManager struct {
 
 func NewAuctionManager(
 	auctionRepo repositories.AuctionRepository,
+	itemRepo repositories.ItemRepository,
+	outboxRepo repositories.OutboxRepository,
 	stateCache domain.AuctionStateCache,
 	bidCache domain.BidCache,
+	creditCache domain.CreditCache,
 	eventPub domain.EventPublisher,
 	scheduler domain.AuctionScheduler,
 	leaderElection domain.LeaderElection,
@@ -38,8 +65,11 @@ func NewAuctionManager(
 ) *AuctionManager {
 	return &AuctionManager{
 		auctionRepo:    auctionRepo,
+		itemRepo:       itemRepo,
+		outboxRepo:     outboxRepo,
 		stateCache:     stateCache,
 		bidCache:       bidCache,
+		creditCache:    creditCache,
 		eventPub:       eventPub,
 		scheduler:      scheduler,
 		leaderElection: leaderElection,
@@ -50,33 +80,84 @@ func NewAuctionManager(
 	}
 }
 
-func (am *AuctionManager) CreateAuction(ctx context.Context, startTime, endTime time.Time, startingBid float64) (*domain.Auction, error) {
-	auction := &domain.Auction{
-		ID:        utils.GenerateID("auction"),
-		StartTime: startTime,
-		EndTime:   endTime,
-		StartBid:  startingBid,
-		Status:    domain.AuctionPending,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+// checkAuction looks up what CreateAuction needs beyond the auction settings, so
+// a caller creating several auctions can find a bad one before creating any
+func (am *AuctionManager) checkAuction(ctx context.Context, auction *domain.Auction) error {
+	if auction.ItemID != "" {
+		if _, err := am.itemRepo.GetItem(ctx, auction.ItemID); err != nil {
+			return err
+		}
+	}
+
+	if auction.Currency != "" {
+		if _, err := domain.LookupCurrency(auction.Currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateAuction persists and schedules a new auction. The caller fills in the
+// auction settings; ID, status and timestamps are assigned here. A linked item
+// supplies the seller, title and category.
+func (am *AuctionManager) CreateAuction(ctx context.Context, auction *domain.Auction) (*domain.Auction, error) {
+	if auction.ItemID != "" {
+		item, err := am.itemRepo.GetItem(ctx, auction.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		auction.SellerID = item.SellerID
+		auction.Title = item.Title
+		auction.Category = item.CategoryID
+	}
+
+	if auction.Currency == "" {
+		auction.Currency = domain.DefaultCurrency
+	}
+	currency, err := domain.LookupCurrency(auction.Currency)
+	if err != nil {
+		return nil, err
+	}
+	auction.Currency = currency.Code
+
+	auction.ID = utils.GenerateID("auction")
+	auction.OriginalEndTime = auction.EndTime
+	if auction.Type == "" {
+		auction.Type = domain.AuctionEnglish
 	}
+	auction.Status = domain.AuctionPending
+	auction.CurrentPrice = auction.StartBid
+	auction.CreatedAt = time.Now()
+	auction.UpdatedAt = time.Now()
 
 	if err := am.auctionRepo.CreateAuction(ctx, auction); err != nil {
 		return nil, err
 	}
 
-	// Initialize in Redis with starting bid and increment rule
-	incrementRule := am.biddingRuleDao.GetIncrementRule(startingBid)
-	if err := am.bidCache.InitializeBidding(ctx, auction.ID, startingBid, incrementRule); err != nil {
+	// Store the auction's increment tiers, falling back to the currency's or the global default tiers
+	if len(auction.IncrementTiers) == 0 {
+		auction.IncrementTiers = currency.DefaultTiers
+		if auction.IncrementTiers == nil {
+			auction.IncrementTiers = am.biddingRuleDao.DefaultTiers()
+		}
+	}
+	rules := &domain.BidValidationRules{Tiers: auction.IncrementTiers}
+	if err := am.biddingRuleDao.SaveAuctionRules(ctx, auction.ID, rules); err != nil {
+		return nil, err
+	}
+
+	// Initialize in Redis with starting bid, increment rule and auction settings
+	incrementRule := currency.RoundIncrement(rules.GetIncrementRule(auction.StartBid))
+	if err := am.bidCache.InitializeBidding(ctx, auction, incrementRule); err != nil {
 		return nil, err
 	}
 
 	// Schedule start and end
-	if err := am.scheduler.ScheduleAuctionStart(ctx, auction.ID, startTime); err != nil {
+	if err := am.scheduler.ScheduleAuctionStart(ctx, auction.ID, auction.StartTime); err != nil {
 		return nil, err
 	}
 
-	if err := am.scheduler.ScheduleAuctionEnd(ctx, auction.ID, endTime); err != nil {
+	if err := am.scheduler.ScheduleAuctionEnd(ctx, auction.ID, auction.EndTime); err != nil {
 		return nil, err
 	}
 
@@ -84,19 +165,152 @@ func (am *AuctionManager) CreateAuction(ctx context.Context, startTime, endTime
 	return auction, nil
 }
 
+// GetAuctionDetails loads the auction from MySQL and merges in the live price,
+// leader and status from Redis
+func (am *AuctionManager) GetAuctionDetails(ctx context.Context, auctionID string) (*AuctionDetails, error) {
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := am.biddingRuleDao.GetAuctionRules(ctx, auctionID)
+	if err != nil {
+		return nil, err
+	}
+	auction.IncrementTiers = rules.Tiers
+
+	currency, err := domain.LookupCurrency(auction.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := am.bidCache.GetCurrentBid(ctx, auctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &AuctionDetails{
+		Auction:      auction,
+		Item:         am.itemSummary(ctx, auction.ItemID),
+		Status:       auction.Status,
+		CurrentPrice: live.CurrentBid,
+		LeaderID:     live.WinnerID,
+		BidCount:     live.BidCount,
+	}
+
+	if status, err := am.stateCache.GetAuctionStatus(ctx, auctionID); err != nil {
+		am.log.Warn("Failed to read live auction status", "auction_id", auctionID, "error", err)
+	} else {
+		details.Status = status
+	}
+
+	// Expired Redis state (long after the auction ended) falls back to the stored
+	// price, which the end recorded, and to the starting bid for auctions without one
+	if details.CurrentPrice == 0 {
+		details.CurrentPrice = auction.CurrentPrice
+	}
+	if details.CurrentPrice == 0 {
+		details.CurrentPrice = auction.StartBid
+	}
+
+	switch {
+	case auction.Type.IsSealed():
+		// Sealed bids stay hidden until the auction is resolved
+		if details.Status == domain.AuctionPending || details.Status == domain.AuctionActive ||
+			details.Status == domain.AuctionPaused {
+			details.LeaderID = ""
+		}
+		details.NextMinimumBid = auction.StartBid
+	case auction.Type == domain.AuctionDutch:
+		details.NextMinimumBid = details.CurrentPrice
+	default:
+		details.NextMinimumBid = details.CurrentPrice + currency.RoundIncrement(rules.GetIncrementRule(details.CurrentPrice))
+	}
+
+	switch details.Status {
+	case domain.AuctionPending, domain.AuctionActive:
+		details.TimeRemaining = time.Until(auction.EndTime)
+	case domain.AuctionPaused:
+		if !auction.PausedAt.IsZero() {
+			details.TimeRemaining = auction.EndTime.Sub(auction.PausedAt)
+		}
+	}
+	if details.TimeRemaining < 0 {
+		details.TimeRemaining = 0
+	}
+
+	return details, nil
+}
+
 func (am *AuctionManager) StartAuction(ctx context.Context, auctionID string) error {
 	isLeader, err := am.leaderElection.IsLeader(ctx, am.instanceID)
 	if err != nil || !isLeader {
 		return err
 	}
 
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	// A cancelled auction must not be started by a job that was already picked up
+	if auction.Status != domain.AuctionPending {
+		am.log.Info("Skipping start of non-pending auction", "auction_id", auctionID, "status", auction.Status)
+		return nil
+	}
+
 	am.log.Info("Starting auction", "auction_id", auctionID)
 
-	if err := am.auctionRepo.UpdateAuctionStatus(ctx, auctionID, domain.AuctionActive); err != nil {
+	err = am.transition(ctx, auctionID, domain.AuctionPending, domain.AuctionActive,
+		domain.ActorScheduler, "start time reached", nil)
+	if errors.Is(err, domain.ErrInvalidStateTransition) {
+		am.log.Info("Auction left pending before start", "auction_id", auctionID)
+		return nil
+	}
+	if err != nil {
 		return err
 	}
 
-	return am.stateCache.SetAuctionStatus(ctx, auctionID, domain.AuctionActive)
+	if auction.Type == domain.AuctionDutch {
+		return am.scheduler.SchedulePriceDrop(ctx, auctionID, time.Now().Add(auction.DutchStepInterval))
+	}
+	return nil
+}
+
+// DropDutchPrice lowers the price of a running Dutch auction by one step and
+// schedules the next drop until the floor price is reached.
+func (am *AuctionManager) DropDutchPrice(ctx context.Context, auctionID string) error {
+	isLeader, err := am.leaderElection.IsLeader(ctx, am.instanceID)
+	if err != nil || !isLeader {
+		return err
+	}
+
+	currentStatus, err := am.stateCache.GetAuctionStatus(ctx, auctionID)
+	if err != nil || currentStatus != domain.AuctionActive {
+		return err
+	}
+
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	price, dropped, err := am.bidCache.DropPrice(ctx, auctionID, auction.DutchPriceStep, auction.DutchFloorPrice)
+	if err != nil {
+		return err
+	}
+
+	// Nothing dropped means the auction was accepted or already sits at the floor
+	if !dropped {
+		return nil
+	}
+
+	am.log.Info("Dutch price dropped", "auction_id", auctionID, "price", price)
+
+	if price > auction.DutchFloorPrice {
+		return am.scheduler.SchedulePriceDrop(ctx, auctionID, time.Now().Add(auction.DutchStepInterval))
+	}
+	return nil
 }
 
 func (am *AuctionManager) EndAuction(ctx context.Context, auctionID string) error {
@@ -113,27 +327,275 @@ func (am *AuctionManager) EndAuction(ctx context.Context, auctionID string) erro
 		return nil
 	}
 
-	// Update status
-	if err := am.auctionRepo.UpdateAuctionStatus(ctx, auctionID, domain.AuctionEnded); err != nil {
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	// Bidding is closed before the outcome is read, so no late bid can change
+	// it once the end is recorded
+	closed, err := am.bidCache.CloseBidding(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	event, err := am.endOutcome(ctx, auction)
+	if err != nil {
 		return err
 	}
 
-	if err := am.stateCache.SetAuctionStatus(ctx, auctionID, domain.AuctionEnded); err != nil {
+	// The compare-and-set settles races between the end job, the local timer and
+	// buy-now, which all read the same outcome
+	err = am.transition(ctx, auctionID, domain.AuctionActive, domain.AuctionEnded,
+		domain.ActorScheduler, "end time reached", event)
+	if errors.Is(err, domain.ErrInvalidStateTransition) {
+		if closed {
+			return am.reopenAfterLostEnd(ctx, auctionID)
+		}
+		return nil
+	}
+	if err != nil {
 		return err
 	}
 
 	// Cancel any pending timers
 	am.cancelTimer(auctionID)
 
-	// Publish end event
-	return am.eventPub.PublishBiddingEvent(ctx, &domain.BidEvent{
+	if err := am.finishEnd(ctx, event); err != nil {
+		return err
+	}
+
+	return am.publishOutboxEvent(ctx, event)
+}
+
+// reopenAfterLostEnd undoes the close of an end that lost its compare-and-set.
+// Only a pause in between leaves the auction to be bid on again once it is
+// resumed; after a cancel or another end, bidding stays closed.
+func (am *AuctionManager) reopenAfterLostEnd(ctx context.Context, auctionID string) error {
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+	if auction.Status != domain.AuctionPaused {
+		return nil
+	}
+
+	am.log.Info("Auction paused while ending, reopening bidding", "auction_id", auctionID)
+	return am.bidCache.ReopenBidding(ctx, auctionID)
+}
+
+// endOutcome works out how an auction whose bidding is closed ends: its winner
+// and final price, or no winner if the reserve was not met
+func (am *AuctionManager) endOutcome(ctx context.Context, auction *domain.Auction) (*domain.BidEvent, error) {
+	var finalBid *domain.LocalAuctionCache
+	var err error
+	if auction.Type.IsSealed() {
+		finalBid, err = am.resolveSealedAuction(ctx, auction)
+	} else {
+		finalBid, err = am.bidCache.GetCurrentBid(ctx, auction.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	event := &domain.BidEvent{
+		EventID:   utils.GenerateID("event"),
 		Type:      domain.AuctionEndedBidRejected,
-		AuctionID: auctionID,
+		AuctionID: auction.ID,
+		UserID:    finalBid.WinnerID,
+		Amount:    finalBid.CurrentBid,
 		Timestamp: time.Now(),
-	})
+	}
+
+	// A reserve that was not reached ends the auction without a winner
+	if auction.ReservePrice > 0 && (finalBid.WinnerID == "" || finalBid.CurrentBid < auction.ReservePrice) {
+		am.log.Info("Auction reserve not met", "auction_id", auction.ID, "final_bid", finalBid.CurrentBid)
+		event.Type = domain.AuctionReserveNotMet
+		event.UserID = ""
+	}
+	return event, nil
+}
+
+// finishEnd stores the final price of an ended auction and leaves only the
+// winner's credit hold. Running it again gives the same result, so the outbox
+// relay can finish ends that were never published.
+func (am *AuctionManager) finishEnd(ctx context.Context, event *domain.BidEvent) error {
+	auctionID := event.AuctionID
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	// Sealed auctions only get a price once they are resolved
+	if err := am.auctionRepo.UpdateAuctionPrice(ctx, auctionID, event.Amount); err != nil {
+		am.log.Error("Failed to store final auction price", "auction_id", auctionID, "error", err)
+	}
+
+	// Their winner is only written to the bid cache once the end is recorded, so an
+	// end that loses to a pause leaves nothing to undo
+	if auction.Type.IsSealed() {
+		if err := am.bidCache.SetWinningBid(ctx, auctionID, event.UserID, event.Amount); err != nil {
+			return err
+		}
+	}
+
+	// The winner's hold stays at the final price until the auction is settled,
+	// which gives back all holds, so a settled auction is left alone
+	if auction.Status != domain.AuctionEnded {
+		return nil
+	}
+	am.releaseHolds(ctx, auction, event.UserID)
+	if event.UserID != "" {
+		if err := am.creditCache.SetHold(ctx, auctionID, event.UserID, auction.Currency, event.Amount); err != nil {
+			am.log.Error("Failed to set winner's hold", "auction_id", auctionID, "user_id", event.UserID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// releaseHolds gives back the credit bidders have reserved on the auction, except
+// the winner's. Failures are logged: the auction's outcome does not depend on them.
+func (am *AuctionManager) releaseHolds(ctx context.Context, auction *domain.Auction, winnerID string) {
+	if err := am.creditCache.ReleaseHolds(ctx, auction.ID, auction.Currency, winnerID); err != nil {
+		am.log.Error("Failed to release credit holds", "auction_id", auction.ID, "error", err)
+	}
+}
+
+// resolveSealedAuction picks the highest sealed bid as the winner. First-price
+// auctions clear at the winning bid, second-price (Vickrey) auctions at the
+// runner-up's bid, never below the starting bid or reserve.
+func (am *AuctionManager) resolveSealedAuction(ctx context.Context, auction *domain.Auction) (*domain.LocalAuctionCache, error) {
+	result := &domain.LocalAuctionCache{
+		AuctionID:   auction.ID,
+		CurrentBid:  auction.StartBid,
+		LastUpdated: time.Now(),
+	}
+
+	bids, err := am.bidCache.GetSealedBids(ctx, auction.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(bids) == 0 {
+		return result, nil
+	}
+
+	result.WinnerID = bids[0].UserID
+	result.CurrentBid = bids[0].Amount
+
+	if auction.Type == domain.AuctionSealedSecondPrice {
+		clearingPrice := max(auction.StartBid, auction.ReservePrice)
+		if len(bids) > 1 {
+			clearingPrice = max(clearingPrice, bids[1].Amount)
+		}
+		result.CurrentBid = min(clearingPrice, bids[0].Amount)
+	}
+
+	am.log.Info("Sealed auction resolved", "auction_id", auction.ID, "type", auction.Type,
+		"winner", result.WinnerID, "clearing_price", result.CurrentBid, "bids", len(bids))
+	return result, nil
+}
+
+// StartEventListener lets the auction service react to bidding events: late bids
+// extend soft-close auctions, and a buy-it-now or Dutch acceptance ends the auction
+// ahead of its schedule.
+func (am *AuctionManager) StartEventListener(ctx context.Context, subscriber domain.EventSubscriber) error {
+	am.log.Info("Starting auction event listener")
+	return subscriber.SubscribeToBidEvents(ctx, am.handleBidEvent)
+}
+
+func (am *AuctionManager) handleBidEvent(event *domain.BidEvent) error {
+	switch event.Type {
+	case domain.BidAccepted, domain.BidRetracted, domain.BuyNowExecuted, domain.DutchPriceAccepted, domain.DutchPriceDropped:
+		am.syncListingPrice(context.Background(), event)
+	}
+
+	if event.Type == domain.BidAccepted {
+		return am.CheckAndExtendAuction(context.Background(), event.AuctionID, event.Timestamp)
+	}
+
+	if event.Type != domain.BuyNowExecuted && event.Type != domain.DutchPriceAccepted {
+		return nil
+	}
+
+	ctx := context.Background()
+	isLeader, err := am.leaderElection.IsLeader(ctx, am.instanceID)
+	if err != nil || !isLeader {
+		return err
+	}
+
+	am.log.Info("Auction won outright, ending auction", "type", event.Type,
+		"auction_id", event.AuctionID, "user_id", event.UserID)
+
+	if err := am.scheduler.CancelSchedule(ctx, event.AuctionID); err != nil {
+		am.log.Error("Failed to cancel auction schedule", "auction_id", event.AuctionID, "error", err)
+	}
+
+	return am.EndAuction(ctx, event.AuctionID)
 }
 
-func (am *AuctionManager) CheckAndExtendAuction(ctx context.Context, auctionID string, extensionDuration time.Duration) error {
+// syncListingPrice copies the visible price into MySQL so listings can filter
+// and sort by it. Only the leader writes to avoid one update per instance.
+func (am *AuctionManager) syncListingPrice(ctx context.Context, event *domain.BidEvent) {
+	isLeader, err := am.leaderElection.IsLeader(ctx, am.instanceID)
+	if err != nil || !isLeader {
+		return
+	}
+
+	if err := am.auctionRepo.UpdateAuctionPrice(ctx, event.AuctionID, event.Amount); err != nil {
+		am.log.Error("Failed to sync auction price", "auction_id", event.AuctionID, "error", err)
+	}
+}
+
+// ListAuctions returns one page of auctions with summaries of their items
+func (am *AuctionManager) ListAuctions(ctx context.Context, filter *domain.AuctionFilter) (*AuctionPage, error) {
+	auctions, next, err := am.auctionRepo.ListAuctions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &AuctionPage{
+		Auctions: auctions,
+		Items:    make(map[string]*domain.ItemSummary),
+		Next:     next,
+	}
+
+	var itemIDs []string
+	for _, auction := range auctions {
+		if auction.ItemID != "" {
+			itemIDs = append(itemIDs, auction.ItemID)
+		}
+	}
+
+	items, err := am.itemRepo.GetItems(ctx, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	for id, item := range items {
+		page.Items[id] = item.Summary()
+	}
+
+	return page, nil
+}
+
+// itemSummary loads the summary of a linked item. Item details are decoration,
+// so a failed lookup is logged rather than failing the request.
+func (am *AuctionManager) itemSummary(ctx context.Context, itemID string) *domain.ItemSummary {
+	if itemID == "" {
+		return nil
+	}
+
+	item, err := am.itemRepo.GetItem(ctx, itemID)
+	if err != nil {
+		am.log.Error("Failed to load auction item", "item_id", itemID, "error", err)
+		return nil
+	}
+	return item.Summary()
+}
+
+// CheckAndExtendAuction applies the auction's soft-close settings to a bid
+// accepted at bidTime, pushing the end out when the bid landed inside the window.
+func (am *AuctionManager) CheckAndExtendAuction(ctx context.Context, auctionID string, bidTime time.Time) error {
 	isLeader, err := am.leaderElection.IsLeader(ctx, am.instanceID)
 	if err != nil || !isLeader {
 		return err
@@ -145,33 +607,267 @@ func (am *AuctionManager) CheckAndExtendAuction(ctx context.Context, auctionID s
 		return err
 	}
 
-	// Check if auction ends within the extension window
-	timeUntilEnd := time.Until(auction.EndTime)
-	if timeUntilEnd <= extensionDuration && timeUntilEnd > 0 {
-		newEndTime := time.Now().Add(extensionDuration)
+	if auction.SoftCloseWindow <= 0 || auction.Status != domain.AuctionActive {
+		return nil
+	}
+
+	// Check if the bid landed within the soft-close window
+	timeUntilEnd := auction.EndTime.Sub(bidTime)
+	if timeUntilEnd > auction.SoftCloseWindow || timeUntilEnd <= 0 {
+		return nil
+	}
+
+	newEndTime := bidTime.Add(auction.SoftCloseExtension)
+	if auction.SoftCloseMaxExtension > 0 {
+		latestEndTime := auction.OriginalEndTime.Add(auction.SoftCloseMaxExtension)
+		if newEndTime.After(latestEndTime) {
+			newEndTime = latestEndTime
+		}
+	}
+
+	if !newEndTime.After(auction.EndTime) {
+		return nil
+	}
+
+	return am.extendAuction(ctx, auctionID, newEndTime)
+}
+
+// ExtendAuction moves the auction's end time out by extension, regardless of soft-close settings
+func (am *AuctionManager) ExtendAuction(ctx context.Context, auctionID string, extension time.Duration) error {
+	isLeader, err := am.leaderElection.IsLeader(ctx, am.instanceID)
+	if err != nil {
+		return err
+	}
+	if !isLeader {
+		return errors.New("only the leader can extend auctions")
+	}
+
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	if auction.Status != domain.AuctionActive {
+		return fmt.Errorf("%w: cannot extend a %s auction", domain.ErrInvalidStateTransition, auction.Status)
+	}
+
+	return am.extendAuction(ctx, auctionID, auction.EndTime.Add(extension))
+}
+
+func (am *AuctionManager) extendAuction(ctx context.Context, auctionID string, newEndTime time.Time) error {
+	// Persist the new end time
+	if err := am.auctionRepo.UpdateAuctionEndTime(ctx, auctionID, newEndTime); err != nil {
+		return err
+	}
 
-		// Update end time
-		auction.EndTime = newEndTime
-		auction.UpdatedAt = time.Now()
+	if err := am.bidCache.SetEndTime(ctx, auctionID, newEndTime); err != nil {
+		return err
+	}
 
-		// Reschedule
-		if err := am.scheduler.RescheduleAuctionEnd(ctx, auctionID, newEndTime); err != nil {
+	// Reschedule
+	if err := am.scheduler.RescheduleAuctionEnd(ctx, auctionID, newEndTime); err != nil {
+		return err
+	}
+
+	// Set new timer, the scheduler only polls once a minute
+	am.setEndTimer(auctionID, time.Until(newEndTime))
+
+	am.log.Info("Auction extended", "auction_id", auctionID, "new_end_time", newEndTime)
+
+	return am.eventPub.PublishBiddingEvent(ctx, &domain.BidEvent{
+		Type:      domain.AuctionExtended,
+		AuctionID: auctionID,
+		Timestamp: time.Now(),
+		EndTime:   newEndTime,
+	})
+}
+
+// CancelAuction stops a pending, active or paused auction without a winner
+func (am *AuctionManager) CancelAuction(ctx context.Context, auctionID, actor, reason string) error {
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	am.log.Info("Cancelling auction", "auction_id", auctionID, "status", auction.Status, "actor", actor)
+
+	event := &domain.BidEvent{
+		EventID:   utils.GenerateID("event"),
+		Type:      domain.AuctionCancelledEvent,
+		AuctionID: auctionID,
+		Timestamp: time.Now(),
+	}
+	if err := am.transition(ctx, auctionID, auction.Status, domain.AuctionCancelled, actor, reason, event); err != nil {
+		return err
+	}
+
+	if _, err := am.bidCache.CloseBidding(ctx, auctionID); err != nil {
+		return err
+	}
+
+	if err := am.scheduler.CancelSchedule(ctx, auctionID); err != nil {
+		return err
+	}
+	am.cancelTimer(auctionID)
+	am.releaseHolds(ctx, auction, "")
+
+	return am.publishOutboxEvent(ctx, event)
+}
+
+// PauseAuction freezes bidding on an active auction and stops its clock
+func (am *AuctionManager) PauseAuction(ctx context.Context, auctionID, actor, reason string) error {
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	am.log.Info("Pausing auction", "auction_id", auctionID, "actor", actor)
+
+	event := &domain.BidEvent{
+		EventID:   utils.GenerateID("event"),
+		Type:      domain.AuctionPausedEvent,
+		AuctionID: auctionID,
+		Timestamp: time.Now(),
+	}
+	if err := am.transition(ctx, auctionID, auction.Status, domain.AuctionPaused, actor, reason, event); err != nil {
+		return err
+	}
+
+	if err := am.bidCache.FreezeBidding(ctx, auctionID, true); err != nil {
+		return err
+	}
+
+	// The end job is recreated on resume with the remaining time
+	if err := am.scheduler.CancelSchedule(ctx, auctionID); err != nil {
+		return err
+	}
+	am.cancelTimer(auctionID)
+
+	if err := am.auctionRepo.UpdateAuctionPausedAt(ctx, auctionID, time.Now()); err != nil {
+		return err
+	}
+
+	return am.publishOutboxEvent(ctx, event)
+}
+
+// ResumeAuction reopens bidding on a paused auction. The end time moves out by
+// the length of the pause so bidders keep the time they had left.
+func (am *AuctionManager) ResumeAuction(ctx context.Context, auctionID, actor, reason string) error {
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	// The state machine also allows pending to active, which is starting, not resuming
+	if auction.Status != domain.AuctionPaused {
+		return fmt.Errorf("%w: cannot resume a %s auction", domain.ErrInvalidStateTransition, auction.Status)
+	}
+
+	event := &domain.BidEvent{
+		EventID:   utils.GenerateID("event"),
+		Type:      domain.AuctionResumedEvent,
+		AuctionID: auctionID,
+		Timestamp: time.Now(),
+	}
+	if err := am.transition(ctx, auctionID, domain.AuctionPaused, domain.AuctionActive, actor, reason, event); err != nil {
+		return err
+	}
+
+	newEndTime := auction.EndTime
+	if !auction.PausedAt.IsZero() {
+		newEndTime = auction.EndTime.Add(time.Since(auction.PausedAt))
+	}
+
+	am.log.Info("Resuming auction", "auction_id", auctionID, "new_end_time", newEndTime)
+
+	if err := am.auctionRepo.UpdateAuctionEndTime(ctx, auctionID, newEndTime); err != nil {
+		return err
+	}
+
+	if err := am.bidCache.SetEndTime(ctx, auctionID, newEndTime); err != nil {
+		return err
+	}
+
+	if err := am.scheduler.ScheduleAuctionEnd(ctx, auctionID, newEndTime); err != nil {
+		return err
+	}
+	am.setEndTimer(auctionID, time.Until(newEndTime))
+
+	if auction.Type == domain.AuctionDutch {
+		if err := am.scheduler.SchedulePriceDrop(ctx, auctionID, time.Now().Add(auction.DutchStepInterval)); err != nil {
 			return err
 		}
+	}
+
+	if err := am.auctionRepo.UpdateAuctionPausedAt(ctx, auctionID, time.Time{}); err != nil {
+		return err
+	}
+
+	if err := am.bidCache.FreezeBidding(ctx, auctionID, false); err != nil {
+		return err
+	}
+
+	return am.publishOutboxEvent(ctx, event)
+}
+
+// SettleAuction marks an ended auction as settled once payment and handover are done
+func (am *AuctionManager) SettleAuction(ctx context.Context, auctionID, actor, reason string) error {
+	auction, err := am.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	am.log.Info("Settling auction", "auction_id", auctionID, "actor", actor)
+
+	if err := am.transition(ctx, auctionID, auction.Status, domain.AuctionSettled, actor, reason, nil); err != nil {
+		return err
+	}
+
+	// The winner has paid, so their hold is no longer needed
+	am.releaseHolds(ctx, auction, "")
+	return nil
+}
 
-		// Set new timer
-		am.setEndTimer(auctionID, extensionDuration)
+func (am *AuctionManager) GetStatusHistory(ctx context.Context, auctionID string) ([]*domain.StatusTransition, error) {
+	if _, err := am.auctionRepo.GetAuction(ctx, auctionID); err != nil {
+		return nil, err
+	}
 
-		// Publish extension event
-		am.eventPub.PublishBiddingEvent(ctx, &domain.BidEvent{
-			Type:      domain.AuctionExtended,
-			AuctionID: auctionID,
-			Timestamp: time.Now(),
-		})
+	return am.auctionRepo.GetStatusHistory(ctx, auctionID)
+}
 
-		am.log.Info("Auction extended", "auction_id", auctionID, "new_end_time", newEndTime)
+// transition moves an auction through the state machine. MySQL decides races with a
+// compare-and-set on the status column and records the history row, and the event
+// announcing the change if there is one, in the same transaction; the state cache
+// follows afterwards. The caller publishes the event with publishOutboxEvent.
+func (am *AuctionManager) transition(ctx context.Context, auctionID string,
+	from, to domain.AuctionStatus, actor, reason string, event *domain.BidEvent) error {
+	if err := domain.ValidateTransition(from, to); err != nil {
+		return err
 	}
 
+	record := &domain.StatusTransition{
+		AuctionID: auctionID,
+		From:      from,
+		To:        to,
+		Actor:     actor,
+		Reason:    reason,
+		Timestamp: time.Now(),
+	}
+	if err := am.auctionRepo.TransitionAuctionStatus(ctx, record, event); err != nil {
+		return err
+	}
+
+	swapped, err := am.stateCache.CompareAndSetStatus(ctx, auctionID, from, to)
+	if err != nil {
+		return err
+	}
+
+	// MySQL already committed the transition, so a stale cache is brought in line with it
+	if !swapped {
+		am.log.Warn("Auction status cache out of sync", "auction_id", auctionID, "from", from, "to", to)
+		return am.stateCache.SetAuctionStatus(ctx, auctionID, to)
+	}
 	return nil
 }
 
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/domain/repositories"
+	"auction-system/pkg/logger"
+	"auction-system/pkg/utils"
+)
+
+// CatalogService manages the items sold in auctions and the category tree they belong to
+type CatalogService struct {
+	itemRepo repositories.ItemRepository
+	log      logger.Logger
+}
+
+func NewCatalogService(itemRepo repositories.ItemRepository, log logger.Logger) *CatalogService {
+	return &CatalogService{
+		itemRepo: itemRepo,
+		log:      log,
+	}
+}
+
+func (s *CatalogService) CreateItem(ctx context.Context, item *domain.Item) (*domain.Item, error) {
+	if err := s.checkCategory(ctx, item.CategoryID); err != nil {
+		return nil, err
+	}
+
+	item.ID = utils.GenerateID("item")
+	item.CreatedAt = time.Now()
+	item.UpdatedAt = item.CreatedAt
+
+	if err := s.itemRepo.CreateItem(ctx, item); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Item created", "item_id", item.ID, "seller_id", item.SellerID)
+	return item, nil
+}
+
+func (s *CatalogService) GetItem(ctx context.Context, itemID string) (*domain.Item, error) {
+	return s.itemRepo.GetItem(ctx, itemID)
+}
+
+// UpdateItem replaces the item's details. The seller and creation time cannot change.
+func (s *CatalogService) UpdateItem(ctx context.Context, item *domain.Item) (*domain.Item, error) {
+	existing, err := s.itemRepo.GetItem(ctx, item.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCategory(ctx, item.CategoryID); err != nil {
+		return nil, err
+	}
+
+	item.SellerID = existing.SellerID
+	item.CreatedAt = existing.CreatedAt
+	item.UpdatedAt = time.Now()
+
+	if err := s.itemRepo.UpdateItem(ctx, item); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Item updated", "item_id", item.ID)
+	return item, nil
+}
+
+func (s *CatalogService) DeleteItem(ctx context.Context, itemID string) error {
+	if err := s.itemRepo.DeleteItem(ctx, itemID); err != nil {
+		return err
+	}
+
+	s.log.Info("Item deleted", "item_id", itemID)
+	return nil
+}
+
+func (s *CatalogService) CreateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	if err := s.checkCategory(ctx, category.ParentID); err != nil {
+		return nil, err
+	}
+
+	category.ID = utils.GenerateID("category")
+	category.CreatedAt = time.Now()
+
+	if err := s.itemRepo.CreateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Category created", "category_id", category.ID, "parent_id", category.ParentID)
+	return category, nil
+}
+
+func (s *CatalogService) ListCategories(ctx context.Context) ([]*domain.Category, error) {
+	return s.itemRepo.ListCategories(ctx)
+}
+
+// checkCategory verifies that a referenced category exists; an empty ID means none
+func (s *CatalogService) checkCategory(ctx context.Context, categoryID string) error {
+	if categoryID == "" {
+		return nil
+	}
+
+	_, err := s.itemRepo.GetCategory(ctx, categoryID)
+	return err
+}
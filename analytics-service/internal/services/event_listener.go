@@ -39,28 +39,165 @@ func (el *EventListener) handleBidEvent(event *domain.BidEvent) error {
 		return el.handleBidAccepted(event)
 	case domain.BidRejected:
 		return el.handleBidRejected(event)
-	case domain.AuctionEndedBidRejected:
+	case domain.BidRetracted:
+		return el.handleBidRetracted(event)
+	case domain.BidRateLimited:
+		// The bidder already got a bid_rejected reply; the event is for analytics
+		return nil
+	case domain.AuctionEndedBidRejected, domain.AuctionReserveNotMet:
 		return el.handleAuctionEnded(event)
 	case domain.AuctionExtended:
 		return el.handleAuctionExtended(event)
+	case domain.BuyNowExecuted:
+		return el.handleBuyNow(event)
+	case domain.SealedBidPlaced:
+		return el.handleSealedBid(event)
+	case domain.DutchPriceDropped:
+		return el.handlePriceDropped(event)
+	case domain.DutchPriceAccepted:
+		return el.handleDutchAccepted(event)
+	case domain.AuctionCancelledEvent:
+		return el.handleAuctionCancelled(event)
+	case domain.AuctionPausedEvent:
+		return el.handleAuctionPaused(event)
+	case domain.AuctionResumedEvent:
+		return el.handleAuctionResumed(event)
+	case domain.EventsMissed:
+		return el.handleEventsMissed(event)
 	}
 
 	return errors.New(fmt.Sprintf("unknown event type %+v", *event))
 }
 
+// broadcast sends an update to the auction's connections and, for a lot of a
+// sale, to the connections following the whole sale. Sale subscribers get the
+// lot's auction_id so they can tell the lots apart. Every update carries the
+// sequence number of its event, so clients can spot updates they missed.
+func (el *EventListener) broadcast(event *domain.BidEvent, message map[string]interface{}) error {
+	ctx := context.Background()
+	auctionID := event.AuctionID
+	message["sequence"] = event.Sequence
+	if err := el.broadcaster.BroadcastToAuction(ctx, auctionID, message); err != nil {
+		return err
+	}
+
+	saleID, err := el.bidService.SaleID(ctx, auctionID)
+	if err != nil {
+		el.log.Error("Failed to look up sale of auction", "auction_id", auctionID, "error", err)
+		return nil
+	}
+	if saleID == "" {
+		return nil
+	}
+
+	saleMessage := make(map[string]interface{}, len(message)+2)
+	for k, v := range message {
+		saleMessage[k] = v
+	}
+	saleMessage["auction_id"] = auctionID
+	saleMessage["sale_id"] = saleID
+
+	return el.broadcaster.BroadcastToAuction(ctx, saleID, saleMessage)
+}
+
 func (el *EventListener) handleBidAccepted(event *domain.BidEvent) error {
 	// Update local cache
-	el.bidService.UpdateLocalCache(event.AuctionID, event.Amount, event.UserID)
+	el.bidService.UpdateLocalCache(event)
 
-	// Broadcast to all connected users for this auction
-	return el.broadcaster.BroadcastToAuction(context.Background(), event.AuctionID, map[string]interface{}{
+	// Broadcast to all connected users for this auction. event.Amount is the visible
+	// price only; proxy ceilings never leave the bid cache.
+	return el.broadcast(event, map[string]interface{}{
 		"type":           "bid_update",
 		"current_bid":    event.Amount,
 		"current_winner": event.UserID,
+		"reserve_met":    el.bidService.IsReserveMet(context.Background(), event.AuctionID, event.Amount),
 		"timestamp":      event.Timestamp,
 	})
 }
 
+// handleBidRetracted broadcasts the price and leader the remaining bids produce.
+// Who retracted which bid stays between the bidder and the admins.
+func (el *EventListener) handleBidRetracted(event *domain.BidEvent) error {
+	el.bidService.UpdateLocalCache(event)
+
+	return el.broadcast(event, map[string]interface{}{
+		"type":           "bid_retracted",
+		"current_bid":    event.Amount,
+		"current_winner": event.UserID,
+		"reserve_met":    el.bidService.IsReserveMet(context.Background(), event.AuctionID, event.Amount),
+		"timestamp":      event.Timestamp,
+	})
+}
+
+func (el *EventListener) handleBuyNow(event *domain.BidEvent) error {
+	el.bidService.UpdateLocalCache(event)
+
+	// Bidding is closed in Redis; the leader follows up with the auction_ended event
+	return el.broadcast(event, map[string]interface{}{
+		"type":      "buy_now",
+		"winner":    event.UserID,
+		"price":     event.Amount,
+		"timestamp": event.Timestamp,
+	})
+}
+
+func (el *EventListener) handleSealedBid(event *domain.BidEvent) error {
+	// Sealed bids are only confirmed to the bidder, never broadcast to the auction
+	return el.connectionManager.NotifyUser(event.UserID, map[string]interface{}{
+		"type":       "sealed_bid_received",
+		"auction_id": event.AuctionID,
+		"bid_id":     event.BidID,
+		"amount":     event.Amount,
+		"timestamp":  event.Timestamp,
+	})
+}
+
+func (el *EventListener) handlePriceDropped(event *domain.BidEvent) error {
+	el.bidService.UpdateLocalCache(event)
+
+	return el.broadcast(event, map[string]interface{}{
+		"type":          "price_drop",
+		"current_price": event.Amount,
+		"timestamp":     event.Timestamp,
+	})
+}
+
+func (el *EventListener) handleDutchAccepted(event *domain.BidEvent) error {
+	el.bidService.UpdateLocalCache(event)
+
+	// Bidding is closed in Redis; the leader follows up with the auction_ended event
+	return el.broadcast(event, map[string]interface{}{
+		"type":      "dutch_accepted",
+		"winner":    event.UserID,
+		"price":     event.Amount,
+		"timestamp": event.Timestamp,
+	})
+}
+
+// handleEventsMissed reloads an auction whose updates were lost and sends the
+// whole state, so clients that got the same gap catch up too
+func (el *EventListener) handleEventsMissed(event *domain.BidEvent) error {
+	auctionCache, err := el.bidService.RefreshAuctionCache(context.Background(), event.AuctionID)
+	if err != nil {
+		return err
+	}
+
+	message := map[string]interface{}{
+		"type":        "auction_state",
+		"current_bid": auctionCache.CurrentBid,
+		"reserve_met": auctionCache.CurrentBid >= auctionCache.ReservePrice,
+		"end_time":    auctionCache.EndTime,
+		"timestamp":   event.Timestamp,
+	}
+	if !auctionCache.AuctionType.IsSealed() {
+		message["current_winner"] = auctionCache.WinnerID
+	}
+
+	// The state is as of the auction's latest event, not the one that showed the gap
+	event.Sequence = auctionCache.Sequence
+	return el.broadcast(event, message)
+}
+
 func (el *EventListener) handleBidRejected(event *domain.BidEvent) error {
 
 	return nil
@@ -72,10 +209,21 @@ func (el *EventListener) handleAuctionEnded(event *domain.BidEvent) error {
 	el.bidService.RemoveFromCache(event.AuctionID)
 
 	// Final broadcast
-	if err := el.broadcaster.BroadcastToAuction(context.Background(), event.AuctionID, map[string]interface{}{
+	message := map[string]interface{}{
 		"type":      "auction_ended",
+		"outcome":   "sold",
+		"winner":    event.UserID,
+		"final_bid": event.Amount,
 		"timestamp": event.Timestamp,
-	}); err != nil {
+	}
+	if event.Type == domain.AuctionReserveNotMet {
+		message["outcome"] = string(domain.AuctionReserveNotMet)
+		delete(message, "winner")
+	} else if event.UserID == "" {
+		message["outcome"] = "no_bids"
+	}
+
+	if err := el.broadcast(event, message); err != nil {
 		el.log.Error("Failed to broadcast auction ended event", "error", err)
 		return err
 	}
@@ -89,8 +237,61 @@ func (el *EventListener) handleAuctionEnded(event *domain.BidEvent) error {
 }
 
 func (el *EventListener) handleAuctionExtended(event *domain.BidEvent) error {
-	return el.broadcaster.BroadcastToAuction(context.Background(), event.AuctionID, map[string]interface{}{
+	endTime := event.EndTime
+	if endTime.IsZero() {
+		// Published by a version that left the end time to be read from the bid cache
+		auctionCache, err := el.bidService.RefreshAuctionCache(context.Background(), event.AuctionID)
+		if err != nil {
+			return err
+		}
+		endTime = auctionCache.EndTime
+	} else {
+		el.bidService.SetCachedEndTime(event.AuctionID, endTime)
+	}
+
+	return el.broadcast(event, map[string]interface{}{
 		"type":      "auction_extended",
+		"end_time":  endTime,
+		"timestamp": event.Timestamp,
+	})
+}
+
+func (el *EventListener) handleAuctionCancelled(event *domain.BidEvent) error {
+	el.bidService.RemoveFromCache(event.AuctionID)
+
+	// Tell clients before their connections are closed
+	if err := el.broadcast(event, map[string]interface{}{
+		"type":      "auction_cancelled",
+		"timestamp": event.Timestamp,
+	}); err != nil {
+		el.log.Error("Failed to broadcast auction cancelled event", "error", err)
+		return err
+	}
+
+	if err := el.connectionManager.CloseAndUnregisterConnections(event.AuctionID); err != nil {
+		el.log.Error("Failed to finalize connections for auction", "auction_id",
+			event.AuctionID, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (el *EventListener) handleAuctionPaused(event *domain.BidEvent) error {
+	return el.broadcast(event, map[string]interface{}{
+		"type":      "auction_paused",
+		"timestamp": event.Timestamp,
+	})
+}
+
+func (el *EventListener) handleAuctionResumed(event *domain.BidEvent) error {
+	auctionCache, err := el.bidService.RefreshAuctionCache(context.Background(), event.AuctionID)
+	if err != nil {
+		return err
+	}
+
+	return el.broadcast(event, map[string]interface{}{
+		"type":      "auction_resumed",
+		"end_time":  auctionCache.EndTime,
 		"timestamp": event.Timestamp,
 	})
 }
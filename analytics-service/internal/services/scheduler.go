@@ -77,6 +77,19 @@ func (s *CronAuctionScheduler) ScheduleAuctionEnd(ctx context.Context, auctionID
 	return s.repo.CreateJob(ctx, job)
 }
 
+func (s *CronAuctionScheduler) SchedulePriceDrop(ctx context.Context, auctionID string, runAt time.Time) error {
+	job := &domain.ScheduledJob{
+		ID:        utils.GenerateID("job"),
+		AuctionID: auctionID,
+		JobType:   domain.JobPriceDrop,
+		RunAt:     runAt,
+		Status:    domain.JobPending,
+		CreatedAt: time.Now(),
+	}
+
+	return s.repo.CreateJob(ctx, job)
+}
+
 func (s *CronAuctionScheduler) RescheduleAuctionEnd(ctx context.Context, auctionID string, newEndTime time.Time) error {
 	// Cancel existing end jobs
 	if err := s.repo.CancelJobsForAuction(ctx, auctionID); err != nil {
@@ -107,6 +120,8 @@ func (s *CronAuctionScheduler) processPendingJobs(ctx context.Context) {
 			err = s.auctionMgr.StartAuction(ctx, job.AuctionID)
 		case domain.JobEndAuction:
 			err = s.auctionMgr.EndAuction(ctx, job.AuctionID)
+		case domain.JobPriceDrop:
+			err = s.auctionMgr.DropDutchPrice(ctx, job.AuctionID)
 		}
 
 		status := domain.JobExecuted
@@ -14,6 +14,8 @@ import (
 	"time"
 
 	"auction-system/internal/config"
+	"auction-system/internal/domain"
+	"auction-system/internal/infrastructure/exchange"
 	"auction-system/internal/infrastructure/leader"
 	"auction-system/internal/infrastructure/mysql"
 	"auction-system/internal/infrastructure/redis"
@@ -67,12 +69,40 @@ func main() {
 	// TODO: Add loggers and proper logging in each components
 	// Initialize repositories
 	auctionRepo := mysql.NewMySQLAuctionRepository(db)
+	itemRepo := mysql.NewMySQLItemRepository(db)
+	saleRepo := mysql.NewMySQLSaleRepository(db)
+	retractionRepo := mysql.NewMySQLRetractionRepository(db)
+	creditRepo := mysql.NewMySQLCreditRepository(db)
+	outboxRepo := mysql.NewMySQLOutboxRepository(db)
 	schedulerRepo := mysql.NewMySQLSchedulerRepository(db)
 
 	// Initialize Redis based components
-	bidCache := redis.NewBidCache(rdb)
+	events := redis.EventSink{
+		Producer:     cfg.Instance.ID,
+		Streams:      cfg.Events.UseStreams(),
+		StreamMaxLen: cfg.Events.StreamMaxLen,
+	}
+	bidCache := redis.NewBidCache(rdb, events)
 	stateCache := redis.NewStateCache(rdb)
-	eventPublisher := redis.NewEventPublisher(rdb)
+	creditCache := redis.NewCreditCache(rdb)
+	eventPublisher := redis.NewEventPublisher(rdb, events)
+
+	deadLetters := redis.NewDeadLetterStore(rdb)
+	delivery := redis.DeliveryPolicy{
+		MaxAttempts: cfg.Events.HandlerMaxAttempts,
+		Backoff:     cfg.Events.HandlerBackoff,
+		DeadLetters: deadLetters,
+	}
+
+	// Every instance sees every event, with a consumer group of its own on the
+	// stream; the leader is the one that acts on them. The service name keeps
+	// the group apart from other services' instances of the same ID.
+	consumerGroup := "auction-service:" + cfg.Instance.ID
+	var eventSubscriber domain.EventSubscriber = redis.NewRedisEventSubscriber(rdb, consumerGroup, delivery, log)
+	if cfg.Events.UseStreams() {
+		eventSubscriber = redis.NewStreamEventSubscriber(rdb, consumerGroup, cfg.Instance.ID, cfg.Events.ClaimIdle,
+			delivery, log)
+	}
 
 	//Initialize validator
 	biddingRuleDao := services.NewBiddingRuleDao(rdb)
@@ -81,6 +111,23 @@ func main() {
 		os.Exit(1)
 	}
 
+	creditService := services.NewCreditService(creditRepo, creditCache, log)
+	if err := creditService.LoadCreditLimits(ctx); err != nil {
+		log.Error("Failed to load credit limits", "error", err)
+		os.Exit(1)
+	}
+
+	// Exchange rates are only used to convert prices for display
+	var exchangeRates domain.ExchangeRateProvider
+	if cfg.ExchangeRates.File != "" {
+		staticRates, err := exchange.NewStaticRateProvider(cfg.ExchangeRates.File)
+		if err != nil {
+			log.Error("Failed to load exchange rates", "file", cfg.ExchangeRates.File, "error", err)
+			os.Exit(1)
+		}
+		exchangeRates = staticRates
+	}
+
 	// Initialize leader election
 	leaderElection := leader.NewRedisLeaderElection(rdb, cfg.Leader.TTL)
 
@@ -88,8 +135,11 @@ func main() {
 	//TODO: Remove this cyclic dependency later!!
 	auctionManager := services.NewAuctionManager(
 		auctionRepo,
+		itemRepo,
+		outboxRepo,
 		stateCache,
 		bidCache,
+		creditCache,
 		eventPublisher,
 		nil, // scheduler will be set below
 		leaderElection,
@@ -117,13 +167,51 @@ func main() {
 	addCorsDebuggingMiddleware(e, log)
 
 	// Initialize handlers
-	auctionHandler := handlers.NewAuctionHandler(auctionManager, log)
+	auctionHandler := handlers.NewAuctionHandler(auctionManager, services.NewCurrencyService(exchangeRates, log), log)
+	itemHandler := handlers.NewItemHandler(services.NewCatalogService(itemRepo, log), log)
+	saleHandler := handlers.NewSaleHandler(services.NewSaleManager(saleRepo, auctionManager, log), log)
+	creditHandler := handlers.NewCreditHandler(creditService, log)
+	deadLetterHandler := handlers.NewDeadLetterHandler(services.NewDeadLetterService(deadLetters, log), log)
+	retractionHandler := handlers.NewRetractionHandler(
+		services.NewRetractionService(retractionRepo, auctionRepo, bidCache, biddingRuleDao, log), log)
 
 	// API routes
 	api := e.Group("/api/v1")
 	api.POST("/auctions", auctionHandler.CreateAuction)
+	api.GET("/auctions", auctionHandler.ListAuctions)
 	api.GET("/auctions/:id", auctionHandler.GetAuction)
 	api.POST("/auctions/:id/extend", auctionHandler.ExtendAuction)
+	api.GET("/auctions/:id/history", auctionHandler.GetAuctionHistory)
+	api.POST("/auctions/:id/retractions", retractionHandler.RequestRetraction)
+	api.GET("/retractions/:id", retractionHandler.GetRetraction)
+	api.GET("/bidders/:id/credit", creditHandler.GetCreditAccount)
+	api.POST("/items", itemHandler.CreateItem)
+	api.GET("/items/:id", itemHandler.GetItem)
+	api.PUT("/items/:id", itemHandler.UpdateItem)
+	api.DELETE("/items/:id", itemHandler.DeleteItem)
+	api.POST("/categories", itemHandler.CreateCategory)
+	api.GET("/categories", itemHandler.ListCategories)
+	api.POST("/sales", saleHandler.CreateSale)
+	api.GET("/sales/:id", saleHandler.GetSale)
+
+	// Admin routes
+	admin := api.Group("/admin")
+	admin.POST("/auctions/:id/cancel", auctionHandler.CancelAuction)
+	admin.POST("/auctions/:id/pause", auctionHandler.PauseAuction)
+	admin.POST("/auctions/:id/resume", auctionHandler.ResumeAuction)
+	admin.POST("/auctions/:id/settle", auctionHandler.SettleAuction)
+	admin.GET("/auctions/:id/ladder", retractionHandler.GetBidLadder)
+	admin.GET("/retractions", retractionHandler.ListRetractions)
+	admin.POST("/retractions/:id/approve", retractionHandler.ApproveRetraction)
+	admin.POST("/retractions/:id/reject", retractionHandler.RejectRetraction)
+	admin.PUT("/bidders/:id/credit", creditHandler.SetCreditLimit)
+	admin.POST("/sales/:id/cancel", saleHandler.CancelSale)
+	admin.POST("/sales/:id/pause", saleHandler.PauseSale)
+	admin.POST("/sales/:id/resume", saleHandler.ResumeSale)
+	admin.GET("/dead-letters", deadLetterHandler.ListDeadLetters)
+	admin.GET("/dead-letters/:id", deadLetterHandler.GetDeadLetter)
+	admin.POST("/dead-letters/:id/replay", deadLetterHandler.ReplayDeadLetter)
+	admin.DELETE("/dead-letters/:id", deadLetterHandler.DiscardDeadLetter)
 
 	// Health check endpoint
 	e.GET("/health", healthStatusHandler(cfg))
@@ -137,6 +225,18 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := auctionManager.StartEventListener(context.Background(), eventSubscriber); err != nil {
+			log.Error("Failed to start auction event listener", "error", err)
+		}
+	}()
+
+	go func() {
+		if err := auctionManager.StartOutboxRelay(context.Background(), 5*time.Second); err != nil {
+			log.Error("Outbox relay stopped", "error", err)
+		}
+	}()
+
 	go func() {
 		for {
 			became, err := leaderElection.BecomeLeader(context.Background(), cfg.Instance.ID)
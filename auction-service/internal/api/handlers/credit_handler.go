@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/services"
+	"auction-system/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CreditHandler struct {
+	creditService *services.CreditService
+	log           logger.Logger
+}
+
+type CreditLimitRequest struct {
+	Currency    string       `json:"currency"`
+	CreditLimit domain.Money `json:"credit_limit"`
+}
+
+type HoldResponse struct {
+	AuctionID string       `json:"auction_id"`
+	Amount    domain.Money `json:"amount"`
+}
+
+// CreditAccountResponse leaves credit_limit and available out for bidders without a limit
+type CreditAccountResponse struct {
+	UserID      string         `json:"user_id"`
+	Currency    string         `json:"currency"`
+	CreditLimit *domain.Money  `json:"credit_limit,omitempty"`
+	Held        domain.Money   `json:"held"`
+	Available   *domain.Money  `json:"available,omitempty"`
+	Holds       []HoldResponse `json:"holds"`
+}
+
+func newCreditAccountResponse(account *domain.CreditAccount) CreditAccountResponse {
+	response := CreditAccountResponse{
+		UserID:   account.UserID,
+		Currency: account.Currency,
+		Held:     account.Held(),
+		Holds:    make([]HoldResponse, 0, len(account.Holds)),
+	}
+	if !account.Unlimited {
+		available := account.Available()
+		response.CreditLimit = &account.Limit
+		response.Available = &available
+	}
+	for auctionID, amount := range account.Holds {
+		response.Holds = append(response.Holds, HoldResponse{AuctionID: auctionID, Amount: amount})
+	}
+	sort.Slice(response.Holds, func(i, j int) bool {
+		return response.Holds[i].AuctionID < response.Holds[j].AuctionID
+	})
+	return response
+}
+
+func NewCreditHandler(creditService *services.CreditService, log logger.Logger) *CreditHandler {
+	return &CreditHandler{
+		creditService: creditService,
+		log:           log,
+	}
+}
+
+// GetCreditAccount shows the bidder's limit and holds in the currency query
+// parameter, which defaults to the default currency
+func (h *CreditHandler) GetCreditAccount(c echo.Context) error {
+	userID := c.Param("id")
+	currency := c.QueryParam("currency")
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+
+	account, err := h.creditService.GetCreditAccount(c.Request().Context(), userID, currency)
+	if errors.Is(err, domain.ErrUnsupportedCurrency) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if err != nil {
+		h.log.Error("Failed to load credit account", "user_id", userID, "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load credit account"})
+	}
+
+	return c.JSON(http.StatusOK, newCreditAccountResponse(account))
+}
+
+func (h *CreditHandler) SetCreditLimit(c echo.Context) error {
+	userID := c.Param("id")
+
+	var req CreditLimitRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+	if req.Currency == "" {
+		req.Currency = domain.DefaultCurrency
+	}
+	if req.CreditLimit < 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Credit limit cannot be negative"})
+	}
+
+	account, err := h.creditService.SetCreditLimit(c.Request().Context(), &domain.CreditLimit{
+		UserID:   userID,
+		Currency: req.Currency,
+		Limit:    req.CreditLimit,
+	})
+	if errors.Is(err, domain.ErrUnsupportedCurrency) || errors.Is(err, domain.ErrInvalidMoney) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if err != nil {
+		h.log.Error("Failed to set credit limit", "user_id", userID, "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to set credit limit"})
+	}
+
+	return c.JSON(http.StatusOK, newCreditAccountResponse(account))
+}
@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/services"
+	"auction-system/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RetractionHandler struct {
+	retractionService *services.RetractionService
+	log               logger.Logger
+}
+
+// RetractionRequest names the bid to take back by its bid ID or, for a bid
+// placed without one, by its amount
+type RetractionRequest struct {
+	UserID string       `json:"user_id"`
+	BidID  string       `json:"bid_id"`
+	Amount domain.Money `json:"amount"`
+	Reason string       `json:"reason"`
+}
+
+type RetractionResponse struct {
+	RetractionID string       `json:"retraction_id"`
+	AuctionID    string       `json:"auction_id"`
+	UserID       string       `json:"user_id"`
+	BidID        string       `json:"bid_id,omitempty"`
+	Amount       domain.Money `json:"amount"`
+	Reason       string       `json:"reason"`
+	Status       string       `json:"status"`
+	RequestedAt  time.Time    `json:"requested_at"`
+	DecidedBy    string       `json:"decided_by,omitempty"`
+	DecisionNote string       `json:"decision_note,omitempty"`
+	DecidedAt    *time.Time   `json:"decided_at,omitempty"`
+}
+
+type LadderBidResponse struct {
+	UserID    string       `json:"user_id"`
+	BidID     string       `json:"bid_id,omitempty"`
+	Amount    domain.Money `json:"amount"`
+	MaxAmount domain.Money `json:"max_amount"`
+	PlacedAt  time.Time    `json:"placed_at"`
+}
+
+// Validate checks the request and returns a client-facing error message
+func (req *RetractionRequest) Validate() error {
+	if req.UserID == "" {
+		return errors.New("User ID is required")
+	}
+
+	if req.BidID != "" && !domain.ValidBidID(req.BidID) {
+		return errors.New("Bid ID must be 1-64 letters, digits, '-' or '_'")
+	}
+
+	if req.BidID == "" && req.Amount <= 0 {
+		return errors.New("Either a bid ID or the amount of the bid is required")
+	}
+
+	if len(req.Reason) > 512 {
+		return errors.New("Reason must be at most 512 characters")
+	}
+
+	return nil
+}
+
+func newRetractionResponse(retraction *domain.BidRetraction) RetractionResponse {
+	response := RetractionResponse{
+		RetractionID: retraction.ID,
+		AuctionID:    retraction.AuctionID,
+		UserID:       retraction.UserID,
+		BidID:        retraction.BidID,
+		Amount:       retraction.Amount,
+		Reason:       retraction.Reason,
+		Status:       string(retraction.Status),
+		RequestedAt:  retraction.RequestedAt,
+		DecidedBy:    retraction.DecidedBy,
+		DecisionNote: retraction.DecisionNote,
+	}
+	if !retraction.DecidedAt.IsZero() {
+		response.DecidedAt = &retraction.DecidedAt
+	}
+	return response
+}
+
+func NewRetractionHandler(retractionService *services.RetractionService, log logger.Logger) *RetractionHandler {
+	return &RetractionHandler{
+		retractionService: retractionService,
+		log:               log,
+	}
+}
+
+func (h *RetractionHandler) RequestRetraction(c echo.Context) error {
+	var req RetractionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	retraction, err := h.retractionService.RequestRetraction(c.Request().Context(), &domain.BidRetraction{
+		AuctionID: c.Param("id"),
+		UserID:    req.UserID,
+		BidID:     req.BidID,
+		Amount:    req.Amount,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return h.retractionError(c, "request", err)
+	}
+
+	return c.JSON(http.StatusCreated, newRetractionResponse(retraction))
+}
+
+func (h *RetractionHandler) GetRetraction(c echo.Context) error {
+	retraction, err := h.retractionService.GetRetraction(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return h.retractionError(c, "load", err)
+	}
+
+	return c.JSON(http.StatusOK, newRetractionResponse(retraction))
+}
+
+// ListRetractions filters by the optional auction_id and status query parameters
+func (h *RetractionHandler) ListRetractions(c echo.Context) error {
+	status := domain.RetractionStatus(c.QueryParam("status"))
+	switch status {
+	case "", domain.RetractionPending, domain.RetractionApproved, domain.RetractionRejected:
+	default:
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Status must be pending, approved or rejected"})
+	}
+
+	retractions, err := h.retractionService.ListRetractions(c.Request().Context(), c.QueryParam("auction_id"), status)
+	if err != nil {
+		return h.retractionError(c, "list", err)
+	}
+
+	response := make([]RetractionResponse, 0, len(retractions))
+	for _, retraction := range retractions {
+		response = append(response, newRetractionResponse(retraction))
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"retractions": response,
+	})
+}
+
+// GetBidLadder shows an auction's bids, including the hidden proxy ceilings, to admins
+func (h *RetractionHandler) GetBidLadder(c echo.Context) error {
+	auctionID := c.Param("id")
+
+	ladder, err := h.retractionService.GetBidLadder(c.Request().Context(), auctionID)
+	if errors.Is(err, domain.ErrAuctionNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Auction not found"})
+	}
+	if err != nil {
+		h.log.Error("Failed to load bid ladder", "auction_id", auctionID, "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load bid ladder"})
+	}
+
+	bids := make([]LadderBidResponse, 0, len(ladder))
+	for _, bid := range ladder {
+		bids = append(bids, LadderBidResponse{
+			UserID:    bid.UserID,
+			BidID:     bid.BidID,
+			Amount:    bid.Amount,
+			MaxAmount: bid.MaxAmount,
+			PlacedAt:  bid.PlacedAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"auction_id": auctionID,
+		"bids":       bids,
+	})
+}
+
+func (h *RetractionHandler) ApproveRetraction(c echo.Context) error {
+	return h.decideRetraction(c, "approve", h.retractionService.ApproveRetraction)
+}
+
+func (h *RetractionHandler) RejectRetraction(c echo.Context) error {
+	return h.decideRetraction(c, "reject", h.retractionService.RejectRetraction)
+}
+
+func (h *RetractionHandler) decideRetraction(c echo.Context, action string,
+	decide func(ctx context.Context, retractionID, actor, note string) (*domain.BidRetraction, error)) error {
+	retractionID := c.Param("id")
+
+	var req StateChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+	if req.Actor == "" {
+		req.Actor = "admin"
+	}
+
+	h.log.Info("Retraction decision requested", "retraction_id", retractionID, "action", action, "actor", req.Actor)
+
+	retraction, err := decide(c.Request().Context(), retractionID, req.Actor, req.Reason)
+	if err != nil {
+		return h.retractionError(c, action, err)
+	}
+
+	return c.JSON(http.StatusOK, newRetractionResponse(retraction))
+}
+
+func (h *RetractionHandler) retractionError(c echo.Context, action string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrRetractionNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Retraction not found"})
+	case errors.Is(err, domain.ErrAuctionNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Auction not found"})
+	case errors.Is(err, domain.ErrBidNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "No such bid on the auction's bid ladder"})
+	case errors.Is(err, domain.ErrRetractionDecided), errors.Is(err, domain.ErrRetractionNotAllowed):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+
+	h.log.Error("Failed to "+action+" retraction", "id", c.Param("id"), "error", err)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to " + action + " retraction"})
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"regexp"
+	"time"
+)
+
+// BidIDTTL is how long the outcome of a bid with a bid ID is remembered. A
+// resend within this window gets the original outcome instead of bidding again.
+const BidIDTTL = 24 * time.Hour
+
+var bidIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// BidRequest is a bid as submitted by a bidder
+type BidRequest struct {
+	AuctionID string
+	UserID    string
+	BidID     string // client-generated idempotency key, optional
+	Amount    Money
+	MaxAmount Money  // proxy ceiling, 0 for a plain bid
+	Currency  string // must be the auction's currency; empty means the auction's
+}
+
+// BidOutcome is what became of a bid. Reason is the bid cache's reason code,
+// e.g. "success", "insufficient_increment" or "auction_not_found".
+type BidOutcome struct {
+	Accepted bool
+	Reason   string
+	Replayed bool // the bid ID was seen before; this is the outcome of the first attempt
+
+	// The auction as the bidder sees it after the bid
+	CurrentPrice   Money
+	Leading        bool // never set for sealed auctions, whose leader stays hidden
+	NextMinimumBid Money
+}
+
+// ValidBidID reports whether id can be used as a bid ID. Bid IDs end up in
+// Redis keys and event payloads, so they are limited to letters, digits, '-' and '_'.
+func ValidBidID(id string) bool {
+	return bidIDPattern.MatchString(id)
+}
@@ -1,16 +1,54 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Cache interfaces
 type BidCache interface {
-	AtomicBidUpdate(ctx context.Context, auctionID, userID string, amount float64) (bool, error)
+	AtomicBidUpdate(ctx context.Context, bid *BidRequest) (*BidOutcome, error)
+	// GetBidOutcome returns the remembered outcome of a bid ID, or nil if it has not been seen
+	GetBidOutcome(ctx context.Context, auctionID, userID, bidID string) (*BidOutcome, error)
 	GetCurrentBid(ctx context.Context, auctionID string) (*LocalAuctionCache, error)
-	SetBiddingIncrementRule(ctx context.Context, auctionID string, rule float64) error
-	InitializeBidding(ctx context.Context, auctionID string, startingBid float64, incrementRule float64) error
+	SetBiddingIncrementRule(ctx context.Context, auctionID string, rule Money) error
+	SetEndTime(ctx context.Context, auctionID string, endTime time.Time) error
+	// FreezeBidding makes AtomicBidUpdate reject bids while frozen, CloseBidding rejects them for good.
+	// CloseBidding reports whether bidding was still open.
+	FreezeBidding(ctx context.Context, auctionID string, frozen bool) error
+	CloseBidding(ctx context.Context, auctionID string) (bool, error)
+	// ReopenBidding undoes a CloseBidding whose end did not go through
+	ReopenBidding(ctx context.Context, auctionID string) error
+	InitializeBidding(ctx context.Context, auction *Auction, incrementRule Money) error
+	// GetSealedBids returns the sealed bids ordered from highest to lowest, earliest first on ties
+	GetSealedBids(ctx context.Context, auctionID string) ([]*SealedBid, error)
+	SetWinningBid(ctx context.Context, auctionID, userID string, amount Money) error
+	// DropPrice lowers a Dutch auction's price by step without going below floor.
+	// It returns the resulting price and whether a drop happened.
+	DropPrice(ctx context.Context, auctionID string, step, floor Money) (Money, bool, error)
+	// GetBidLadder returns the English bids that counted, oldest first
+	GetBidLadder(ctx context.Context, auctionID string) ([]*LadderBid, error)
+	// RetractBid removes the bid the retraction names from the ladder and replays the
+	// remaining bids from the starting bid. It returns the resulting price and leader,
+	// or ErrBidNotFound if the ladder has no such bid.
+	RetractBid(ctx context.Context, retraction *BidRetraction, startBid, startIncrement Money) (Money, string, error)
+}
+
+// CreditCache keeps the credit limits the bid cache checks bids against and the
+// holds it places. Holds move with the lead inside the bid cache; the auction's
+// lifecycle releases them.
+type CreditCache interface {
+	SetCreditLimit(ctx context.Context, limit *CreditLimit) error
+	GetCreditAccount(ctx context.Context, userID, currency string) (*CreditAccount, error)
+	// SetHold replaces the bidder's hold on the auction
+	SetHold(ctx context.Context, auctionID, userID, currency string, amount Money) error
+	// ReleaseHolds releases every hold on the auction except exceptUserID's
+	ReleaseHolds(ctx context.Context, auctionID, currency, exceptUserID string) error
 }
 
 type AuctionStateCache interface {
 	SetAuctionStatus(ctx context.Context, auctionID string, status AuctionStatus) error
 	GetAuctionStatus(ctx context.Context, auctionID string) (AuctionStatus, error)
+	// CompareAndSetStatus sets the status only if it is currently from
+	CompareAndSetStatus(ctx context.Context, auctionID string, from, to AuctionStatus) (bool, error)
 }
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+)
+
+const DefaultCurrency = "USD"
+
+// Currency is an ISO 4217 currency. Money always counts hundredths, so a
+// currency with fewer minor units only accepts amounts on its own step
+// (whole yen for JPY). Currencies with three minor units are not supported.
+type Currency struct {
+	Code       string
+	MinorUnits int
+
+	// DefaultTiers replace the global default increment tiers for auctions in
+	// this currency; nil means the global tiers already fit
+	DefaultTiers []IncrementTier
+}
+
+var currencies = map[string]Currency{
+	"USD": {Code: "USD", MinorUnits: 2},
+	"EUR": {Code: "EUR", MinorUnits: 2},
+	"GBP": {Code: "GBP", MinorUnits: 2},
+	"CHF": {Code: "CHF", MinorUnits: 2},
+	"CAD": {Code: "CAD", MinorUnits: 2},
+	"AUD": {Code: "AUD", MinorUnits: 2},
+	"SEK": {Code: "SEK", MinorUnits: 2},
+	"JPY": {Code: "JPY", MinorUnits: 0, DefaultTiers: []IncrementTier{
+		{From: 0, To: 1_000_000, Increment: 10_000},         // below ¥10,000 by ¥100
+		{From: 1_000_000, To: 5_000_000, Increment: 50_000}, // below ¥50,000 by ¥500
+		{From: 5_000_000, Increment: 100_000},               // then by ¥1,000
+	}},
+	"KRW": {Code: "KRW", MinorUnits: 0, DefaultTiers: []IncrementTier{
+		{From: 0, To: 10_000_000, Increment: 100_000},          // below ₩100,000 by ₩1,000
+		{From: 10_000_000, To: 50_000_000, Increment: 500_000}, // below ₩500,000 by ₩5,000
+		{From: 50_000_000, Increment: 1_000_000},               // then by ₩10,000
+	}},
+}
+
+// LookupCurrency returns the supported currency with the given code, case-insensitively
+func LookupCurrency(code string) (Currency, error) {
+	currency, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("%w: %q", ErrUnsupportedCurrency, code)
+	}
+	return currency, nil
+}
+
+// Step is the smallest amount of the currency
+func (c Currency) Step() Money {
+	step := Money(1)
+	for i := c.MinorUnits; i < 2; i++ {
+		step *= 10
+	}
+	return step
+}
+
+// ValidateAmount rejects amounts finer than the currency's minor unit
+func (c Currency) ValidateAmount(m Money) error {
+	if m%c.Step() != 0 {
+		return fmt.Errorf("%w: %s has no minor unit below %s", ErrInvalidMoney, c.Code, c.Step())
+	}
+	return nil
+}
+
+// Round rounds the amount to the nearest step of the currency
+func (c Currency) Round(m Money) Money {
+	step := c.Step()
+	return Money(math.Round(float64(m)/float64(step))) * step
+}
+
+// RoundIncrement rounds a (percentage) increment to the currency, never below one step
+func (c Currency) RoundIncrement(increment Money) Money {
+	return max(c.Step(), c.Round(increment))
+}
+
+// ExchangeRateProvider supplies rates for display conversions. Bids are never
+// converted; they are placed and validated in the auction's own currency.
+type ExchangeRateProvider interface {
+	// Rate returns how many units of to one unit of from buys
+	Rate(ctx context.Context, from, to string) (float64, error)
+}
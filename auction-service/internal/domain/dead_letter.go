@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DeadLetter is an event a subscriber gave up on: one it could not parse, or
+// one its handler still failed after every retry. Consumer is the subscriber
+// it failed in, the consumer group for streams or the instance ID for pub/sub,
+// and is where a replay sends it back to. EventID, Type and AuctionID are
+// empty when the payload could not be parsed.
+type DeadLetter struct {
+	ID        string
+	Consumer  string
+	Payload   string
+	EventID   string
+	Type      BidEventType
+	AuctionID string
+	Error     string
+	Attempts  int
+	FailedAt  time.Time
+}
+
+// DeadLetterStore keeps dead letters until an admin replays or discards them
+type DeadLetterStore interface {
+	AddDeadLetter(ctx context.Context, letter *DeadLetter) error
+	// ListDeadLetters returns up to limit dead letters, newest first, of one
+	// consumer or, with consumer empty, of all of them
+	ListDeadLetters(ctx context.Context, consumer string, limit int) ([]*DeadLetter, error)
+	GetDeadLetter(ctx context.Context, id string) (*DeadLetter, error)
+	// ReplayDeadLetter removes the dead letter and queues its payload for its consumer
+	ReplayDeadLetter(ctx context.Context, id string) (*DeadLetter, error)
+	DiscardDeadLetter(ctx context.Context, id string) error
+	// TakeReplays removes and returns up to limit payloads queued for the consumer
+	TakeReplays(ctx context.Context, consumer string, limit int) ([]string, error)
+}
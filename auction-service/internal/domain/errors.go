@@ -0,0 +1,20 @@
+package domain
+
+import "errors"
+
+var (
+	ErrAuctionNotFound        = errors.New("auction not found")
+	ErrInvalidStateTransition = errors.New("invalid auction state transition")
+	ErrItemNotFound           = errors.New("item not found")
+	ErrItemInUse              = errors.New("item is linked to an auction")
+	ErrCategoryNotFound       = errors.New("category not found")
+	ErrSaleNotFound           = errors.New("sale not found")
+	ErrUnsupportedCurrency    = errors.New("unsupported currency")
+	ErrExchangeRateNotFound   = errors.New("exchange rate not available")
+	ErrRetractionNotFound     = errors.New("retraction not found")
+	ErrRetractionDecided      = errors.New("retraction already decided")
+	ErrRetractionNotAllowed   = errors.New("bid cannot be retracted")
+	ErrBidNotFound            = errors.New("bid not found")
+	ErrUnsupportedEventSchema = errors.New("unsupported event schema version")
+	ErrDeadLetterNotFound     = errors.New("dead letter not found")
+)
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+type RetractionStatus string
+
+const (
+	RetractionPending  RetractionStatus = "pending"
+	RetractionApproved RetractionStatus = "approved"
+	RetractionRejected RetractionStatus = "rejected"
+)
+
+// BidRetraction is a bidder's request to take back a mistyped English bid. The
+// bid is named by its bid ID or, for bids placed without one, by its amount
+// (the bidder's latest bid at that amount).
+type BidRetraction struct {
+	ID           string
+	AuctionID    string
+	UserID       string
+	BidID        string
+	Amount       Money
+	Reason       string
+	Status       RetractionStatus
+	RequestedAt  time.Time
+	DecidedBy    string
+	DecisionNote string
+	DecidedAt    time.Time // zero while pending
+}
+
+// Matches reports whether the ladder bid is the one the retraction names
+func (r *BidRetraction) Matches(bid *LadderBid) bool {
+	if bid.UserID != r.UserID {
+		return false
+	}
+	if r.BidID != "" {
+		return bid.BidID == r.BidID
+	}
+	return bid.Amount == r.Amount
+}
+
+// LadderBid is one English bid that counted towards the auction's price. The
+// ladder keeps them in order so a retraction can replay the others.
+type LadderBid struct {
+	UserID    string
+	BidID     string
+	Amount    Money
+	MaxAmount Money
+	PlacedAt  time.Time
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+type SaleStatus string
+
+const (
+	SaleOpen      SaleStatus = "open"
+	SalePaused    SaleStatus = "paused"
+	SaleCancelled SaleStatus = "cancelled"
+)
+
+// Sale is an auction event (catalogue sale) grouping many lots. Every lot is an
+// auction of its own; they open together at StartTime and close one after
+// another, lot N at FirstLotClose + (N-1)×LotInterval.
+type Sale struct {
+	ID            string
+	Title         string
+	StartTime     time.Time
+	FirstLotClose time.Time
+	LotInterval   time.Duration
+	Status        SaleStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// LotEndTime returns when the lot with the given 1-based number closes
+func (s *Sale) LotEndTime(lotNumber int) time.Time {
+	return s.FirstLotClose.Add(time.Duration(lotNumber-1) * s.LotInterval)
+}
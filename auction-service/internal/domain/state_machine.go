@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Actors recorded for transitions that are not triggered by a person
+const (
+	ActorScheduler = "scheduler"
+	ActorSystem    = "system"
+)
+
+// auctionTransitions lists the statuses each status may move to.
+// Cancelled and settled auctions are final.
+var auctionTransitions = map[AuctionStatus][]AuctionStatus{
+	AuctionPending: {AuctionActive, AuctionCancelled},
+	AuctionActive:  {AuctionPaused, AuctionEnded, AuctionCancelled},
+	AuctionPaused:  {AuctionActive, AuctionCancelled},
+	AuctionEnded:   {AuctionSettled},
+}
+
+func (s AuctionStatus) CanTransitionTo(to AuctionStatus) bool {
+	for _, allowed := range auctionTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns ErrInvalidStateTransition for moves the state machine does not allow
+func ValidateTransition(from, to AuctionStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStateTransition, from, to)
+	}
+	return nil
+}
+
+// StatusTransition is a single entry of an auction's status history
+type StatusTransition struct {
+	ID        int64         `json:"id"`
+	AuctionID string        `json:"auction_id"`
+	From      AuctionStatus `json:"-"`
+	To        AuctionStatus `json:"-"`
+	Actor     string        `json:"actor"`
+	Reason    string        `json:"reason"`
+	Timestamp time.Time     `json:"timestamp"`
+}
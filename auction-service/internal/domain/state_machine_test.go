@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateTransition(t *testing.T) {
+	// Written out rather than read from auctionTransitions, so a change to the
+	// state machine has to be made in both places
+	legal := map[[2]AuctionStatus]bool{
+		{AuctionPending, AuctionActive}:    true,
+		{AuctionPending, AuctionCancelled}: true,
+		{AuctionActive, AuctionPaused}:     true,
+		{AuctionActive, AuctionEnded}:      true,
+		{AuctionActive, AuctionCancelled}:  true,
+		{AuctionPaused, AuctionActive}:     true,
+		{AuctionPaused, AuctionCancelled}:  true,
+		{AuctionEnded, AuctionSettled}:     true,
+	}
+
+	for from := AuctionPending; from <= AuctionSettled; from++ {
+		for to := AuctionPending; to <= AuctionSettled; to++ {
+			err := ValidateTransition(from, to)
+			if legal[[2]AuctionStatus{from, to}] {
+				if err != nil {
+					t.Errorf("%s -> %s: got %v, want it allowed", from, to, err)
+				}
+				continue
+			}
+			if !errors.Is(err, ErrInvalidStateTransition) {
+				t.Errorf("%s -> %s: got %v, want ErrInvalidStateTransition", from, to, err)
+			}
+		}
+	}
+}
+
+func TestFinalStatuses(t *testing.T) {
+	for _, status := range []AuctionStatus{AuctionCancelled, AuctionSettled} {
+		for to := AuctionPending; to <= AuctionSettled; to++ {
+			if status.CanTransitionTo(to) {
+				t.Errorf("%s is final but can move to %s", status, to)
+			}
+		}
+	}
+}
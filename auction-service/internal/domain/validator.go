@@ -2,5 +2,5 @@ package domain
 
 // Validation interface
 type BidValidator interface {
-	ValidateIncrement(currentAmount, newAmount float64) bool
+	ValidateIncrement(currentAmount, newAmount Money) bool
 }
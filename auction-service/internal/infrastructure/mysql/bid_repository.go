@@ -16,22 +16,25 @@ func NewMySQLBidRepository(db *sql.DB) *MySQLBidRepository {
 	return &MySQLBidRepository{db: db}
 }
 
+// SaveBidEvent stores an event once even if it is delivered again, going by its
+// event ID. Legacy events have none and are stored every time.
 func (r *MySQLBidRepository) SaveBidEvent(ctx context.Context, event *domain.BidEvent) error {
 	query := `
-        INSERT INTO bid_events (auction_id, user_id, amount, event_type, timestamp, created_at)
-        VALUES (?, ?, ?, ?, ?, ?)
+        INSERT INTO bid_events (event_id, auction_id, user_id, amount, event_type, timestamp, bid_id, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+        ON DUPLICATE KEY UPDATE id = id
     `
 	_, err := r.db.ExecContext(ctx, query,
-		event.AuctionID, event.UserID, event.Amount,
-		string(event.Type), event.Timestamp, time.Now())
+		nullString(event.EventID), event.AuctionID, event.UserID, event.Amount,
+		string(event.Type), event.Timestamp, nullString(event.BidID), time.Now())
 	return err
 }
 
 func (r *MySQLBidRepository) GetBidHistory(ctx context.Context, auctionID string) ([]*domain.BidEvent, error) {
 	query := `
-        SELECT auction_id, user_id, amount, event_type, timestamp
+        SELECT auction_id, user_id, amount, event_type, timestamp, bid_id
         FROM bid_events 
-        WHERE auction_id = ? AND event_type = 'bid_accepted'
+        WHERE auction_id = ? AND event_type IN ('bid_accepted', 'buy_now')
         ORDER BY timestamp ASC
     `
 
@@ -45,12 +48,14 @@ func (r *MySQLBidRepository) GetBidHistory(ctx context.Context, auctionID string
 	for rows.Next() {
 		var event domain.BidEvent
 		var eventType string
+		var bidID sql.NullString
 
 		err := rows.Scan(&event.AuctionID, &event.UserID, &event.Amount,
-			&eventType, &event.Timestamp)
+			&eventType, &event.Timestamp, &bidID)
 		if err != nil {
 			return nil, err
 		}
+		event.BidID = bidID.String
 
 		event.Type = domain.BidEventType(eventType)
 		events = append(events, &event)
@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"auction-system/internal/domain"
+)
+
+type MySQLCreditRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLCreditRepository(db *sql.DB) *MySQLCreditRepository {
+	return &MySQLCreditRepository{db: db}
+}
+
+func (r *MySQLCreditRepository) SetCreditLimit(ctx context.Context, limit *domain.CreditLimit) error {
+	query := `
+        INSERT INTO bidder_credit_limits (user_id, currency, credit_limit, updated_at)
+        VALUES (?, ?, ?, ?)
+        ON DUPLICATE KEY UPDATE credit_limit = VALUES(credit_limit), updated_at = VALUES(updated_at)
+    `
+	_, err := r.db.ExecContext(ctx, query, limit.UserID, limit.Currency, limit.Limit, time.Now())
+	return err
+}
+
+func (r *MySQLCreditRepository) GetCreditLimits(ctx context.Context, userID string) ([]*domain.CreditLimit, error) {
+	return r.queryCreditLimits(ctx, `
+        SELECT user_id, currency, credit_limit FROM bidder_credit_limits
+        WHERE user_id = ? ORDER BY currency
+    `, userID)
+}
+
+func (r *MySQLCreditRepository) ListCreditLimits(ctx context.Context) ([]*domain.CreditLimit, error) {
+	return r.queryCreditLimits(ctx, `
+        SELECT user_id, currency, credit_limit FROM bidder_credit_limits
+        ORDER BY user_id, currency
+    `)
+}
+
+func (r *MySQLCreditRepository) queryCreditLimits(ctx context.Context, query string, args ...interface{}) ([]*domain.CreditLimit, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var limits []*domain.CreditLimit
+	for rows.Next() {
+		var limit domain.CreditLimit
+		if err := rows.Scan(&limit.UserID, &limit.Currency, &limit.Limit); err != nil {
+			return nil, err
+		}
+		limits = append(limits, &limit)
+	}
+
+	return limits, rows.Err()
+}
@@ -0,0 +1,246 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"auction-system/internal/domain"
+
+	driver "github.com/go-sql-driver/mysql"
+)
+
+const itemColumns = `id, seller_id, title, description, item_condition, category_id, images, attributes,
+        created_at, updated_at`
+
+// errForeignKeyRow is returned by MySQL when a delete would orphan referencing rows
+const errForeignKeyRow = 1451
+
+type MySQLItemRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLItemRepository(db *sql.DB) *MySQLItemRepository {
+	return &MySQLItemRepository{db: db}
+}
+
+func (r *MySQLItemRepository) CreateItem(ctx context.Context, item *domain.Item) error {
+	images, attributes, err := marshalItemDetails(item)
+	if err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO items (` + itemColumns + `)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err = r.db.ExecContext(ctx, query,
+		item.ID, item.SellerID, item.Title, item.Description, string(item.Condition),
+		nullString(item.CategoryID), images, attributes, item.CreatedAt, item.UpdatedAt)
+	return err
+}
+
+func (r *MySQLItemRepository) GetItem(ctx context.Context, itemID string) (*domain.Item, error) {
+	query := `SELECT ` + itemColumns + ` FROM items WHERE id = ?`
+
+	item, err := scanItem(r.db.QueryRowContext(ctx, query, itemID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrItemNotFound
+	}
+	return item, err
+}
+
+func (r *MySQLItemRepository) GetItems(ctx context.Context, itemIDs []string) (map[string]*domain.Item, error) {
+	items := make(map[string]*domain.Item, len(itemIDs))
+	if len(itemIDs) == 0 {
+		return items, nil
+	}
+
+	placeholders := make([]string, len(itemIDs))
+	args := make([]interface{}, len(itemIDs))
+	for i, id := range itemIDs {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+
+	query := `SELECT ` + itemColumns + ` FROM items WHERE id IN (` + strings.Join(placeholders, ", ") + `)`
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items[item.ID] = item
+	}
+
+	return items, rows.Err()
+}
+
+func (r *MySQLItemRepository) UpdateItem(ctx context.Context, item *domain.Item) error {
+	images, attributes, err := marshalItemDetails(item)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+        UPDATE items
+        SET title = ?, description = ?, item_condition = ?, category_id = ?, images = ?, attributes = ?,
+            updated_at = ?
+        WHERE id = ?
+    `
+	result, err := tx.ExecContext(ctx, query,
+		item.Title, item.Description, string(item.Condition), nullString(item.CategoryID),
+		images, attributes, item.UpdatedAt, item.ID)
+	if err != nil {
+		return err
+	}
+
+	// MySQL reports 0 rows for an unchanged row as well, so check existence separately
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		var exists int
+		err := tx.QueryRowContext(ctx, `SELECT 1 FROM items WHERE id = ?`, item.ID).Scan(&exists)
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrItemNotFound
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// Listings search the auctions table, so keep its copy of the title and category current
+	_, err = tx.ExecContext(ctx, `UPDATE auctions SET title = ?, category = ? WHERE item_id = ?`,
+		item.Title, item.CategoryID, item.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *MySQLItemRepository) DeleteItem(ctx context.Context, itemID string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM items WHERE id = ?`, itemID)
+	if err != nil {
+		var mysqlErr *driver.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == errForeignKeyRow {
+			return domain.ErrItemInUse
+		}
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrItemNotFound
+	}
+	return nil
+}
+
+func (r *MySQLItemRepository) CreateCategory(ctx context.Context, category *domain.Category) error {
+	query := `INSERT INTO categories (id, parent_id, name, created_at) VALUES (?, ?, ?, ?)`
+	_, err := r.db.ExecContext(ctx, query,
+		category.ID, nullString(category.ParentID), category.Name, category.CreatedAt)
+	return err
+}
+
+func (r *MySQLItemRepository) GetCategory(ctx context.Context, categoryID string) (*domain.Category, error) {
+	query := `SELECT id, parent_id, name, created_at FROM categories WHERE id = ?`
+
+	category, err := scanCategory(r.db.QueryRowContext(ctx, query, categoryID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrCategoryNotFound
+	}
+	return category, err
+}
+
+func (r *MySQLItemRepository) ListCategories(ctx context.Context) ([]*domain.Category, error) {
+	query := `SELECT id, parent_id, name, created_at FROM categories ORDER BY name`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []*domain.Category
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, rows.Err()
+}
+
+func marshalItemDetails(item *domain.Item) ([]byte, []byte, error) {
+	images, err := json.Marshal(item.Images)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attributes, err := json.Marshal(item.Attributes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return images, attributes, nil
+}
+
+func scanItem(row rowScanner) (*domain.Item, error) {
+	var item domain.Item
+	var condition string
+	var categoryID sql.NullString
+	var images, attributes []byte
+
+	err := row.Scan(&item.ID, &item.SellerID, &item.Title, &item.Description, &condition, &categoryID,
+		&images, &attributes, &item.CreatedAt, &item.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	item.Condition = domain.ItemCondition(condition)
+	item.CategoryID = categoryID.String
+
+	if len(images) > 0 {
+		if err := json.Unmarshal(images, &item.Images); err != nil {
+			return nil, err
+		}
+	}
+	if len(attributes) > 0 {
+		if err := json.Unmarshal(attributes, &item.Attributes); err != nil {
+			return nil, err
+		}
+	}
+
+	return &item, nil
+}
+
+func scanCategory(row rowScanner) (*domain.Category, error) {
+	var category domain.Category
+	var parentID sql.NullString
+
+	if err := row.Scan(&category.ID, &parentID, &category.Name, &category.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	category.ParentID = parentID.String
+	return &category, nil
+}
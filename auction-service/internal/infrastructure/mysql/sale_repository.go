@@ -0,0 +1,84 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"auction-system/internal/domain"
+)
+
+type MySQLSaleRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLSaleRepository(db *sql.DB) *MySQLSaleRepository {
+	return &MySQLSaleRepository{db: db}
+}
+
+func (r *MySQLSaleRepository) CreateSale(ctx context.Context, sale *domain.Sale) error {
+	query := `
+        INSERT INTO sales (id, title, start_time, first_lot_close, lot_interval_seconds, status, created_at, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err := r.db.ExecContext(ctx, query,
+		sale.ID, sale.Title, sale.StartTime, sale.FirstLotClose, int(sale.LotInterval.Seconds()),
+		string(sale.Status), sale.CreatedAt, sale.UpdatedAt)
+	return err
+}
+
+func (r *MySQLSaleRepository) GetSale(ctx context.Context, saleID string) (*domain.Sale, error) {
+	query := `
+        SELECT id, title, start_time, first_lot_close, lot_interval_seconds, status, created_at, updated_at
+        FROM sales WHERE id = ?
+    `
+
+	var sale domain.Sale
+	var intervalSeconds int
+	var status string
+
+	err := r.db.QueryRowContext(ctx, query, saleID).Scan(&sale.ID, &sale.Title, &sale.StartTime,
+		&sale.FirstLotClose, &intervalSeconds, &status, &sale.CreatedAt, &sale.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrSaleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sale.LotInterval = time.Duration(intervalSeconds) * time.Second
+	sale.Status = domain.SaleStatus(status)
+	return &sale, nil
+}
+
+func (r *MySQLSaleRepository) UpdateSaleStatus(ctx context.Context, saleID string, status domain.SaleStatus) error {
+	query := `UPDATE sales SET status = ?, updated_at = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, string(status), time.Now(), saleID)
+	return err
+}
+
+func (r *MySQLSaleRepository) GetSaleLots(ctx context.Context, saleID string) ([]*domain.Auction, error) {
+	query := `
+        SELECT ` + auctionColumns + `
+        FROM auctions WHERE sale_id = ?
+        ORDER BY lot_number ASC
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, saleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lots []*domain.Auction
+	for rows.Next() {
+		lot, err := scanAuction(rows)
+		if err != nil {
+			return nil, err
+		}
+		lots = append(lots, lot)
+	}
+
+	return lots, rows.Err()
+}
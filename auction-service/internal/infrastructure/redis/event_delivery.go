@@ -0,0 +1,125 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/pkg/logger"
+)
+
+const (
+	maxHandlerBackoff = 5 * time.Second
+	replayInterval    = 5 * time.Second
+	replayBatch       = 100
+)
+
+// DeliveryPolicy is how a subscriber retries a failing handler and where it
+// puts the events it gives up on. The handler gets MaxAttempts tries; the
+// first retry waits Backoff and each one after that twice as long, up to
+// maxHandlerBackoff. Without DeadLetters, given-up events are only logged.
+type DeliveryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	DeadLetters domain.DeadLetterStore
+}
+
+// eventDelivery is the part of event handling both subscribers share. Retries
+// happen inline, so a failing event holds up the ones behind it rather than
+// letting them overtake it.
+type eventDelivery struct {
+	consumer string
+	policy   DeliveryPolicy
+	log      logger.Logger
+}
+
+// deliver parses the payload and hands it to the handler, dead-lettering it if
+// it cannot be parsed or the handler keeps failing. It returns the event, nil
+// if unparseable, and whether the caller is done with it: false only if it was
+// neither handled nor dead-lettered.
+func (d *eventDelivery) deliver(ctx context.Context, payload string, handler domain.EventHandler) (*domain.BidEvent, bool) {
+	event, err := parseEventData(payload)
+	if err != nil {
+		d.log.Error("Failed to parse event", "consumer", d.consumer, "payload", payload, "error", err)
+		return nil, d.deadLetter(ctx, payload, nil, err, 0)
+	}
+
+	attempts, err := d.handle(ctx, event, handler)
+	if err == nil {
+		return event, true
+	}
+	if ctx.Err() != nil {
+		return event, false
+	}
+
+	d.log.Error("Failed to handle event", "consumer", d.consumer, "event", event, "attempts", attempts, "error", err)
+	return event, d.deadLetter(ctx, payload, event, err, attempts)
+}
+
+// handle runs the handler until it succeeds, runs out of attempts or ctx is done
+func (d *eventDelivery) handle(ctx context.Context, event *domain.BidEvent, handler domain.EventHandler) (int, error) {
+	backoff := d.policy.Backoff
+	for attempt := 1; ; attempt++ {
+		err := handler(event)
+		if err == nil || attempt >= d.policy.MaxAttempts {
+			return attempt, err
+		}
+
+		d.log.Warn("Event handler failed, retrying", "consumer", d.consumer, "event_id", event.EventID,
+			"type", event.Type, "attempt", attempt, "backoff", backoff, "error", err)
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxHandlerBackoff)
+	}
+}
+
+func (d *eventDelivery) deadLetter(ctx context.Context, payload string, event *domain.BidEvent, cause error, attempts int) bool {
+	if d.policy.DeadLetters == nil {
+		return true
+	}
+
+	letter := &domain.DeadLetter{
+		Consumer: d.consumer,
+		Payload:  payload,
+		Error:    cause.Error(),
+		Attempts: attempts,
+		FailedAt: time.Now(),
+	}
+	if event != nil {
+		letter.EventID = event.EventID
+		letter.Type = event.Type
+		letter.AuctionID = event.AuctionID
+	}
+
+	if err := d.policy.DeadLetters.AddDeadLetter(ctx, letter); err != nil {
+		d.log.Error("Failed to dead-letter event", "consumer", d.consumer, "payload", payload, "error", err)
+		return false
+	}
+
+	d.log.Warn("Event dead-lettered", "id", letter.ID, "consumer", d.consumer, "event_id", letter.EventID)
+	return true
+}
+
+// replay delivers the payloads an admin sent back to this consumer. One that
+// fails again is dead-lettered again.
+func (d *eventDelivery) replay(ctx context.Context, handler domain.EventHandler) {
+	if d.policy.DeadLetters == nil {
+		return
+	}
+
+	payloads, err := d.policy.DeadLetters.TakeReplays(ctx, d.consumer, replayBatch)
+	if err != nil {
+		d.log.Error("Failed to take replayed events", "consumer", d.consumer, "error", err)
+		return
+	}
+
+	for _, payload := range payloads {
+		d.log.Info("Replaying dead-lettered event", "consumer", d.consumer)
+		if _, done := d.deliver(ctx, payload, handler); !done {
+			d.log.Error("Replayed event was dropped", "consumer", d.consumer, "payload", payload)
+		}
+	}
+}
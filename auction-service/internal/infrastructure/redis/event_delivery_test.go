@@ -0,0 +1,87 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/pkg/logger"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+func TestEventDelivery(t *testing.T) {
+	const payload = `{"event_id":"event_1","schema_version":1,"type":"bid_accepted","auction_id":"auction_1",` +
+		`"sequence":1,"producer":"p","payload":{"user_id":"user_1","amount":"150.00","timestamp":1760000000,"bid_id":""}}`
+
+	tests := []struct {
+		name         string
+		payload      string
+		failures     int // how often the handler fails before it succeeds
+		wantCalls    int
+		wantLettered bool
+		wantAttempts int
+	}{
+		{name: "succeeds at once", payload: payload, wantCalls: 1},
+		{name: "succeeds on the last attempt", payload: payload, failures: 2, wantCalls: 3},
+		{name: "keeps failing", payload: payload, failures: 10, wantCalls: 3, wantLettered: true, wantAttempts: 3},
+		{name: "unparseable", payload: "garbage", wantLettered: true},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		server := miniredis.RunT(t)
+		client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+		t.Cleanup(func() { client.Close() })
+
+		delivery := &eventDelivery{
+			consumer: "bidding-service:test",
+			policy: DeliveryPolicy{
+				MaxAttempts: 3,
+				Backoff:     time.Millisecond,
+				DeadLetters: NewDeadLetterStore(client),
+			},
+			log: logger.New(),
+		}
+
+		calls := 0
+		handler := func(event *domain.BidEvent) error {
+			calls++
+			if calls <= tt.failures {
+				return errors.New("handler failed")
+			}
+			return nil
+		}
+
+		if _, done := delivery.deliver(ctx, tt.payload, handler); !done {
+			t.Errorf("%s: deliver reported the event not done", tt.name)
+		}
+		if calls != tt.wantCalls {
+			t.Errorf("%s: handler called %d times, want %d", tt.name, calls, tt.wantCalls)
+		}
+
+		messages, err := client.XRange(ctx, "auction_events:dead_letters", "-", "+").Result()
+		if err != nil {
+			t.Fatalf("%s: XRange: %v", tt.name, err)
+		}
+		if !tt.wantLettered {
+			if len(messages) != 0 {
+				t.Errorf("%s: got %d dead letters, want none", tt.name, len(messages))
+			}
+			continue
+		}
+		if len(messages) != 1 {
+			t.Errorf("%s: got %d dead letters, want 1", tt.name, len(messages))
+			continue
+		}
+
+		letter := newDeadLetter(messages[0])
+		if letter.Consumer != delivery.consumer || letter.Payload != tt.payload || letter.Attempts != tt.wantAttempts {
+			t.Errorf("%s: got dead letter %+v, want one from %s after %d attempts", tt.name, letter,
+				delivery.consumer, tt.wantAttempts)
+		}
+	}
+}
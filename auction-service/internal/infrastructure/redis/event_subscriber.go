@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -13,15 +14,28 @@ import (
 	"github.com/go-redis/redis/v8"
 )
 
+// RedisEventSubscriber reads the auction_events channel. Pub/sub drops events
+// while the subscriber is disconnected, so it watches each auction's event
+// sequence and, when one jumps, follows the event with a domain.EventsMissed
+// event for the handler to resync the auction. Events whose handler keeps
+// failing are dead-lettered under consumer, and replays queued for consumer are
+// picked up every replayInterval.
 type RedisEventSubscriber struct {
-	client *redis.Client
-	log    logger.Logger
+	client   *redis.Client
+	delivery eventDelivery
+	log      logger.Logger
+
+	// Last sequence number seen per auction, only touched by the subscribe loop
+	sequences map[string]int64
 }
 
-func NewRedisEventSubscriber(client *redis.Client, log logger.Logger) *RedisEventSubscriber {
+func NewRedisEventSubscriber(client *redis.Client, consumer string, policy DeliveryPolicy,
+	log logger.Logger) *RedisEventSubscriber {
 	return &RedisEventSubscriber{
-		client: client,
-		log:    log,
+		client:    client,
+		delivery:  eventDelivery{consumer: consumer, policy: policy, log: log},
+		log:       log,
+		sequences: make(map[string]int64),
 	}
 }
 
@@ -33,19 +47,29 @@ func (r *RedisEventSubscriber) SubscribeToBidEvents(ctx context.Context, handler
 
 	r.log.Info("Subscribed to auction events")
 
+	replayTicker := time.NewTicker(replayInterval)
+	defer replayTicker.Stop()
+
 	for {
 		select {
 		case msg := <-ch:
-			event, err := r.parseEventData(msg.Payload)
-			if err != nil {
-				r.log.Error("Failed to parse event", "payload", msg.Payload, "error", err)
+			event, _ := r.delivery.deliver(ctx, msg.Payload, handler)
+			if event == nil {
 				continue
 			}
 
-			if err := handler(event); err != nil {
-				r.log.Error("Failed to handle event", "event", event, "error", err)
+			if missed := r.checkSequence(event); missed != nil {
+				r.log.Warn("Missed auction events, resyncing", "auction_id", event.AuctionID,
+					"sequence", event.Sequence)
+				// Synthetic, so there is no payload to dead-letter
+				if _, err := r.delivery.handle(ctx, missed, handler); err != nil {
+					r.log.Error("Failed to handle event", "event", missed, "error", err)
+				}
 			}
 
+		case <-replayTicker.C:
+			r.delivery.replay(ctx, handler)
+
 		case <-ctx.Done():
 			r.log.Info("Event subscriber stopped")
 			return ctx.Err()
@@ -53,14 +77,55 @@ func (r *RedisEventSubscriber) SubscribeToBidEvents(ctx context.Context, handler
 	}
 }
 
-func (r *RedisEventSubscriber) parseEventData(payload string) (*domain.BidEvent, error) {
-	// Parse "auctionID:eventType:userID:amount:timestamp"
+// checkSequence returns the EventsMissed event to hand on after event if the
+// auction's sequence skipped numbers since the last event seen. The first event
+// seen of an auction only starts tracking it, and an ended auction has nothing
+// left to resync.
+func (r *RedisEventSubscriber) checkSequence(event *domain.BidEvent) *domain.BidEvent {
+	if event.Sequence == 0 {
+		return nil
+	}
+
+	switch event.Type {
+	case domain.AuctionEndedBidRejected, domain.AuctionReserveNotMet, domain.AuctionCancelledEvent:
+		delete(r.sequences, event.AuctionID)
+		return nil
+	}
+
+	last, tracked := r.sequences[event.AuctionID]
+	if event.Sequence > last {
+		r.sequences[event.AuctionID] = event.Sequence
+	}
+
+	if !tracked || event.Sequence <= last+1 {
+		return nil
+	}
+	return &domain.BidEvent{
+		Type:      domain.EventsMissed,
+		AuctionID: event.AuctionID,
+		Sequence:  event.Sequence,
+		Timestamp: time.Now(),
+	}
+}
+
+// parseEventData reads a JSON domain.EventEnvelope or, from producers that have
+// not been upgraded yet, the legacy colon-delimited format
+func parseEventData(payload string) (*domain.BidEvent, error) {
+	if strings.HasPrefix(payload, "{") {
+		var envelope domain.EventEnvelope
+		if err := json.Unmarshal([]byte(payload), &envelope); err != nil {
+			return nil, fmt.Errorf("invalid event envelope: %w", err)
+		}
+		return envelope.BidEvent()
+	}
+
+	// Parse "auctionID:eventType:userID:amount:timestamp[:bidID]"
 	parts := strings.Split(payload, ":")
 	if len(parts) < 5 {
 		return nil, fmt.Errorf("invalid event format: %s", payload)
 	}
 
-	amount, err := strconv.ParseFloat(parts[3], 64)
+	amount, err := domain.ParseMoney(parts[3])
 	if err != nil {
 		return nil, err
 	}
@@ -70,11 +135,15 @@ func (r *RedisEventSubscriber) parseEventData(payload string) (*domain.BidEvent,
 		return nil, err
 	}
 
-	return &domain.BidEvent{
+	event := &domain.BidEvent{
 		AuctionID: parts[0],
 		Type:      domain.BidEventType(parts[1]),
 		UserID:    parts[2],
 		Amount:    amount,
 		Timestamp: time.Unix(timestamp, 0),
-	}, nil
+	}
+	if len(parts) > 5 {
+		event.BidID = parts[5]
+	}
+	return event, nil
 }
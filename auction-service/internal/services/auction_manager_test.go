@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/domain/repositories"
+	"auction-system/pkg/logger"
+)
+
+// The fakes embed the interfaces they stand in for, so a call the test does not
+// expect panics on the nil embedded value
+
+type fakeAuctionRepo struct {
+	repositories.AuctionRepository
+	auction *domain.Auction
+	// raceTo, if set, is the status another instance moves the auction to just
+	// before the next compare-and-set
+	raceTo *domain.AuctionStatus
+}
+
+func (r *fakeAuctionRepo) GetAuction(ctx context.Context, auctionID string) (*domain.Auction, error) {
+	auction := *r.auction
+	return &auction, nil
+}
+
+func (r *fakeAuctionRepo) TransitionAuctionStatus(ctx context.Context, transition *domain.StatusTransition,
+	event *domain.BidEvent) error {
+	if r.raceTo != nil {
+		r.auction.Status = *r.raceTo
+		r.raceTo = nil
+	}
+	if r.auction.Status != transition.From {
+		return fmt.Errorf("%w: auction is no longer %s", domain.ErrInvalidStateTransition, transition.From)
+	}
+	r.auction.Status = transition.To
+	return nil
+}
+
+func (r *fakeAuctionRepo) UpdateAuctionPrice(ctx context.Context, auctionID string, price domain.Money) error {
+	r.auction.CurrentPrice = price
+	return nil
+}
+
+type fakeBidCache struct {
+	domain.BidCache
+	closed     bool
+	winnerID   string
+	currentBid domain.Money
+	sealedBids []*domain.SealedBid
+	winnerSet  bool
+}
+
+func (c *fakeBidCache) CloseBidding(ctx context.Context, auctionID string) (bool, error) {
+	wasOpen := !c.closed
+	c.closed = true
+	return wasOpen, nil
+}
+
+func (c *fakeBidCache) ReopenBidding(ctx context.Context, auctionID string) error {
+	c.closed = false
+	return nil
+}
+
+func (c *fakeBidCache) GetCurrentBid(ctx context.Context, auctionID string) (*domain.LocalAuctionCache, error) {
+	return &domain.LocalAuctionCache{AuctionID: auctionID, CurrentBid: c.currentBid, WinnerID: c.winnerID}, nil
+}
+
+func (c *fakeBidCache) GetSealedBids(ctx context.Context, auctionID string) ([]*domain.SealedBid, error) {
+	return c.sealedBids, nil
+}
+
+func (c *fakeBidCache) SetWinningBid(ctx context.Context, auctionID, userID string, amount domain.Money) error {
+	c.winnerSet = true
+	c.winnerID = userID
+	c.currentBid = amount
+	c.closed = true
+	return nil
+}
+
+type fakeStateCache struct {
+	domain.AuctionStateCache
+}
+
+func (c *fakeStateCache) GetAuctionStatus(ctx context.Context, auctionID string) (domain.AuctionStatus, error) {
+	return domain.AuctionActive, nil
+}
+
+func (c *fakeStateCache) CompareAndSetStatus(ctx context.Context, auctionID string,
+	from, to domain.AuctionStatus) (bool, error) {
+	return true, nil
+}
+
+type fakeCreditCache struct {
+	domain.CreditCache
+}
+
+func (c *fakeCreditCache) SetHold(ctx context.Context, auctionID, userID, currency string, amount domain.Money) error {
+	return nil
+}
+
+func (c *fakeCreditCache) ReleaseHolds(ctx context.Context, auctionID, currency, exceptUserID string) error {
+	return nil
+}
+
+type fakeLeaderElection struct {
+	domain.LeaderElection
+}
+
+func (l *fakeLeaderElection) IsLeader(ctx context.Context, instanceID string) (bool, error) {
+	return true, nil
+}
+
+type fakeEventPublisher struct {
+	published []*domain.BidEvent
+}
+
+func (p *fakeEventPublisher) PublishBiddingEvent(ctx context.Context, event *domain.BidEvent) error {
+	p.published = append(p.published, event)
+	return nil
+}
+
+type fakeOutboxRepo struct {
+	repositories.OutboxRepository
+}
+
+func (r *fakeOutboxRepo) MarkEventSent(ctx context.Context, eventID string, sentAt time.Time) error {
+	return nil
+}
+
+func TestEndAuction(t *testing.T) {
+	paused, cancelled := domain.AuctionPaused, domain.AuctionCancelled
+	sealedBids := []*domain.SealedBid{{UserID: "user_1", Amount: 15000, PlacedAt: time.Unix(1, 0)}}
+
+	tests := []struct {
+		name          string
+		auctionType   domain.AuctionType
+		closedBefore  bool // e.g. by a buy-now
+		raceTo        *domain.AuctionStatus
+		wantStatus    domain.AuctionStatus
+		wantClosed    bool
+		wantWinnerSet bool
+		wantPublished bool
+	}{
+		{
+			name:        "english, lost to a pause",
+			auctionType: domain.AuctionEnglish,
+			raceTo:      &paused,
+			wantStatus:  domain.AuctionPaused,
+		},
+		{
+			name:        "sealed, lost to a pause",
+			auctionType: domain.AuctionSealedFirstPrice,
+			raceTo:      &paused,
+			wantStatus:  domain.AuctionPaused,
+		},
+		{
+			name:        "lost to a cancel",
+			auctionType: domain.AuctionEnglish,
+			raceTo:      &cancelled,
+			wantStatus:  domain.AuctionCancelled,
+			wantClosed:  true,
+		},
+		{
+			name:         "closed by a buy-now, lost to a pause",
+			auctionType:  domain.AuctionEnglish,
+			closedBefore: true,
+			raceTo:       &paused,
+			wantStatus:   domain.AuctionPaused,
+			wantClosed:   true,
+		},
+		{
+			name:          "sealed, ended",
+			auctionType:   domain.AuctionSealedFirstPrice,
+			wantStatus:    domain.AuctionEnded,
+			wantClosed:    true,
+			wantWinnerSet: true,
+			wantPublished: true,
+		},
+	}
+
+	for _, tt := range tests {
+		repo := &fakeAuctionRepo{
+			auction: &domain.Auction{
+				ID:       "auction_1",
+				Type:     tt.auctionType,
+				Status:   domain.AuctionActive,
+				StartBid: 10000,
+				Currency: "USD",
+			},
+			raceTo: tt.raceTo,
+		}
+		bidCache := &fakeBidCache{closed: tt.closedBefore, currentBid: 10000, sealedBids: sealedBids}
+		publisher := &fakeEventPublisher{}
+
+		am := NewAuctionManager(repo, nil, &fakeOutboxRepo{}, &fakeStateCache{}, bidCache,
+			&fakeCreditCache{}, publisher, nil, &fakeLeaderElection{}, nil, "instance_1", logger.New())
+
+		if err := am.EndAuction(context.Background(), "auction_1"); err != nil {
+			t.Errorf("%s: EndAuction: %v", tt.name, err)
+			continue
+		}
+
+		if repo.auction.Status != tt.wantStatus {
+			t.Errorf("%s: status %s, want %s", tt.name, repo.auction.Status, tt.wantStatus)
+		}
+		if bidCache.closed != tt.wantClosed {
+			t.Errorf("%s: bidding closed %v, want %v", tt.name, bidCache.closed, tt.wantClosed)
+		}
+		if bidCache.winnerSet != tt.wantWinnerSet {
+			t.Errorf("%s: winning bid set %v, want %v", tt.name, bidCache.winnerSet, tt.wantWinnerSet)
+		}
+		if tt.wantWinnerSet && (bidCache.winnerID != "user_1" || bidCache.currentBid != 15000) {
+			t.Errorf("%s: winner %s at %v, want user_1 at 150.00", tt.name, bidCache.winnerID, bidCache.currentBid)
+		}
+		if (len(publisher.published) > 0) != tt.wantPublished {
+			t.Errorf("%s: published %d events, want published %v", tt.name, len(publisher.published), tt.wantPublished)
+		}
+	}
+}
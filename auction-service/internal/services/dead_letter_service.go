@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+
+	"auction-system/internal/domain"
+	"auction-system/pkg/logger"
+)
+
+// DeadLetterService lets admins deal with the events subscribers gave up on.
+// A replayed event goes back to the consumer it failed in, which picks it up
+// within a few seconds and dead-letters it again if it still fails.
+type DeadLetterService struct {
+	deadLetters domain.DeadLetterStore
+	log         logger.Logger
+}
+
+func NewDeadLetterService(deadLetters domain.DeadLetterStore, log logger.Logger) *DeadLetterService {
+	return &DeadLetterService{
+		deadLetters: deadLetters,
+		log:         log,
+	}
+}
+
+func (ds *DeadLetterService) ListDeadLetters(ctx context.Context, consumer string, limit int) ([]*domain.DeadLetter, error) {
+	return ds.deadLetters.ListDeadLetters(ctx, consumer, limit)
+}
+
+func (ds *DeadLetterService) GetDeadLetter(ctx context.Context, id string) (*domain.DeadLetter, error) {
+	return ds.deadLetters.GetDeadLetter(ctx, id)
+}
+
+func (ds *DeadLetterService) ReplayDeadLetter(ctx context.Context, id, actor string) (*domain.DeadLetter, error) {
+	letter, err := ds.deadLetters.ReplayDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ds.log.Info("Dead letter replayed", "id", id, "consumer", letter.Consumer,
+		"event_id", letter.EventID, "actor", actor)
+	return letter, nil
+}
+
+func (ds *DeadLetterService) DiscardDeadLetter(ctx context.Context, id, actor string) error {
+	if err := ds.deadLetters.DiscardDeadLetter(ctx, id); err != nil {
+		return err
+	}
+
+	ds.log.Info("Dead letter discarded", "id", id, "actor", actor)
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"auction-system/internal/api/handlers"
 	"auction-system/internal/api/middleware"
 	"auction-system/internal/config"
+	"auction-system/internal/domain"
 	"auction-system/internal/infrastructure/mysql"
 	"auction-system/internal/infrastructure/redis"
 	"auction-system/internal/infrastructure/websocket"
@@ -63,24 +64,44 @@ func main() {
 
 	// Initialize repositories
 	auctionRepo := mysql.NewMySQLAuctionRepository(db)
+	itemRepo := mysql.NewMySQLItemRepository(db)
+	saleRepo := mysql.NewMySQLSaleRepository(db)
 
 	// Initialize Redis services
-	bidCache := redis.NewBidCache(rdb)
+	events := redis.EventSink{
+		Producer:     cfg.Instance.ID,
+		Streams:      cfg.Events.UseStreams(),
+		StreamMaxLen: cfg.Events.StreamMaxLen,
+	}
+	bidCache := redis.NewBidCache(rdb, events)
 	stateCache := redis.NewStateCache(rdb)
-	eventSubscriber := redis.NewRedisEventSubscriber(rdb, log)
+	rateLimiter := redis.NewBidRateLimiter(rdb, cfg.RateLimit.PerUser(), cfg.RateLimit.PerAuction(), events)
+
+	// Each replica keeps its own local cache and WebSocket clients up to date,
+	// so on the stream each one reads every event through a group of its own
+	delivery := redis.DeliveryPolicy{
+		MaxAttempts: cfg.Events.HandlerMaxAttempts,
+		Backoff:     cfg.Events.HandlerBackoff,
+		DeadLetters: redis.NewDeadLetterStore(rdb),
+	}
+	consumerGroup := "bidding-service:" + cfg.Instance.ID
+	var eventSubscriber domain.EventSubscriber = redis.NewRedisEventSubscriber(rdb, consumerGroup, delivery, log)
+	if cfg.Events.UseStreams() {
+		eventSubscriber = redis.NewStreamEventSubscriber(rdb, consumerGroup, cfg.Instance.ID, cfg.Events.ClaimIdle,
+			delivery, log)
+	}
 
 	// Initialize connection manager
 	connManager := websocket.NewConnectionManager(log)
 
 	// Initialize notifiers
-	userNotifier := websocket.NewWebSocketNotifier(connManager)
 	auctionBroadcaster := websocket.NewWebSocketNotifier(connManager)
 
 	// Initialize bid service
 	bidService := services.NewBidService(
 		bidCache,
 		stateCache,
-		userNotifier,
+		rateLimiter,
 		log,
 	)
 
@@ -88,7 +109,7 @@ func main() {
 	eventListener := services.NewEventListener(bidService, connManager, auctionBroadcaster, log)
 
 	// Initialize handlers
-	wsHandlers := handlers.NewWebSocketHandlers(bidService, auctionRepo, connManager, log)
+	wsHandlers := handlers.NewWebSocketHandlers(bidService, auctionRepo, itemRepo, saleRepo, connManager, log)
 
 	// Setup routes
 	router := mux.NewRouter()
@@ -96,6 +117,7 @@ func main() {
 
 	// WebSocket routes
 	router.HandleFunc("/ws/auction/{auctionID}", wsHandlers.HandleConnection)
+	router.HandleFunc("/ws/sale/{saleID}", wsHandlers.HandleSaleConnection)
 
 	// Health check
 	router.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -1,36 +1,328 @@
 package handlers
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"auction-system/internal/domain"
 	"auction-system/internal/services"
 	"auction-system/pkg/logger"
 	"github.com/labstack/echo/v4"
 	"net/http"
+	"strconv"
 	"time"
 )
 
 type AuctionHandler struct {
-	auctionManager *services.AuctionManager
-	log            logger.Logger
+	auctionManager  *services.AuctionManager
+	currencyService *services.CurrencyService
+	log             logger.Logger
 }
 
 type CreateAuctionRequest struct {
-	StartTime   time.Time `json:"start_time"`
-	EndTime     time.Time `json:"end_time"`
-	StartingBid float64   `json:"starting_bid"`
+	StartTime    time.Time    `json:"start_time"`
+	EndTime      time.Time    `json:"end_time"`
+	StartingBid  domain.Money `json:"starting_bid"`
+	Currency     string       `json:"currency"` // ISO 4217, defaults to USD
+	AuctionType  string       `json:"auction_type"`
+	ReservePrice domain.Money `json:"reserve_price"`
+	BuyNowPrice  domain.Money `json:"buy_now_price"`
+	BuyNowUntil  time.Time    `json:"buy_now_until"`
+
+	// Listing details. With an item_id the item supplies the seller, title and category.
+	ItemID   string `json:"item_id"`
+	SellerID string `json:"seller_id"`
+	Title    string `json:"title"`
+	Category string `json:"category"`
+
+	// Optional price tiers; the default tiers apply when omitted
+	IncrementTiers []domain.IncrementTier `json:"increment_tiers"`
+
+	// Dutch auctions only
+	DutchPriceStep           domain.Money `json:"dutch_price_step"`
+	DutchStepIntervalSeconds int          `json:"dutch_step_interval_seconds"`
+	DutchFloorPrice          domain.Money `json:"dutch_floor_price"`
+
+	// Soft close (anti-sniping), disabled when the window is 0
+	SoftCloseWindowSeconds       int `json:"soft_close_window_seconds"`
+	SoftCloseExtensionSeconds    int `json:"soft_close_extension_seconds"`
+	SoftCloseMaxExtensionSeconds int `json:"soft_close_max_extension_seconds"`
+}
+
+// AuctionResponse is what anyone may see of an auction's settings. It leaves
+// out the reserve price, which only the seller learns when creating it.
+type AuctionResponse struct {
+	AuctionID                    string                 `json:"auction_id"`
+	ItemID                       string                 `json:"item_id,omitempty"`
+	SaleID                       string                 `json:"sale_id,omitempty"`
+	LotNumber                    int                    `json:"lot_number,omitempty"`
+	SellerID                     string                 `json:"seller_id"`
+	Title                        string                 `json:"title"`
+	Category                     string                 `json:"category"`
+	StartTime                    time.Time              `json:"start_time"`
+	EndTime                      time.Time              `json:"end_time"`
+	StartingBid                  domain.Money           `json:"starting_bid"`
+	Currency                     string                 `json:"currency"`
+	AuctionType                  string                 `json:"auction_type"`
+	BuyNowPrice                  domain.Money           `json:"buy_now_price"`
+	BuyNowUntil                  time.Time              `json:"buy_now_until"`
+	IncrementTiers               []domain.IncrementTier `json:"increment_tiers"`
+	DutchPriceStep               domain.Money           `json:"dutch_price_step,omitempty"`
+	DutchStepIntervalSeconds     int                    `json:"dutch_step_interval_seconds,omitempty"`
+	DutchFloorPrice              domain.Money           `json:"dutch_floor_price,omitempty"`
+	SoftCloseWindowSeconds       int                    `json:"soft_close_window_seconds"`
+	SoftCloseExtensionSeconds    int                    `json:"soft_close_extension_seconds"`
+	SoftCloseMaxExtensionSeconds int                    `json:"soft_close_max_extension_seconds"`
+	Status                       string                 `json:"status"`
 }
 
 type CreateAuctionResponse struct {
-	AuctionID   string    `json:"auction_id"`
-	StartTime   time.Time `json:"start_time"`
-	EndTime     time.Time `json:"end_time"`
-	StartingBid float64   `json:"starting_bid"`
-	Status      string    `json:"status"`
+	AuctionResponse
+	ReservePrice domain.Money `json:"reserve_price"`
+}
+
+func (req *CreateAuctionRequest) auctionType() domain.AuctionType {
+	if req.AuctionType == "" {
+		return domain.AuctionEnglish
+	}
+	return domain.AuctionType(req.AuctionType)
 }
 
-func NewAuctionHandler(auctionManager *services.AuctionManager, log logger.Logger) *AuctionHandler {
+// Validate checks the request and returns a client-facing error message
+func (req *CreateAuctionRequest) Validate() error {
+	if req.StartTime.Before(time.Now()) {
+		return errors.New("Start time must be in the future")
+	}
+
+	if req.EndTime.Before(req.StartTime) {
+		return errors.New("End time must be after start time")
+	}
+
+	if req.StartingBid <= 0 {
+		return errors.New("Starting bid must be positive")
+	}
+
+	if len(req.Title) > 255 || len(req.Category) > 255 || len(req.SellerID) > 255 {
+		return errors.New("Seller, title and category must be at most 255 characters")
+	}
+
+	if req.ReservePrice < 0 {
+		return errors.New("Reserve price must not be negative")
+	}
+
+	auctionType := req.auctionType()
+	if !auctionType.IsValid() {
+		return errors.New("Unknown auction type")
+	}
+
+	if err := req.validateCurrency(); err != nil {
+		return err
+	}
+
+	if len(req.IncrementTiers) > 0 {
+		rules := &domain.BidValidationRules{Tiers: req.IncrementTiers}
+		if err := rules.Validate(); err != nil {
+			return fmt.Errorf("Invalid increment tiers: %w", err)
+		}
+	}
+
+	if auctionType != domain.AuctionEnglish && req.BuyNowPrice > 0 {
+		return errors.New("Buy-it-now is only available for English auctions")
+	}
+
+	if req.BuyNowPrice < 0 {
+		return errors.New("Buy-it-now price must not be negative")
+	}
+
+	if req.BuyNowPrice > 0 && (req.BuyNowPrice <= req.StartingBid || req.BuyNowPrice < req.ReservePrice) {
+		return errors.New("Buy-it-now price must be above the starting bid and not below the reserve price")
+	}
+
+	if !req.BuyNowUntil.IsZero() && (req.BuyNowUntil.Before(req.StartTime) || req.BuyNowUntil.After(req.EndTime)) {
+		return errors.New("Buy-it-now cutoff must be within the auction window")
+	}
+
+	if req.SoftCloseWindowSeconds < 0 || req.SoftCloseExtensionSeconds < 0 || req.SoftCloseMaxExtensionSeconds < 0 {
+		return errors.New("Soft-close settings must not be negative")
+	}
+
+	if req.SoftCloseWindowSeconds > 0 {
+		if auctionType != domain.AuctionEnglish {
+			return errors.New("Soft close is only available for English auctions")
+		}
+
+		if req.SoftCloseExtensionSeconds <= 0 {
+			return errors.New("Soft-close extension must be positive when a window is set")
+		}
+	}
+
+	if auctionType == domain.AuctionDutch {
+		if req.ReservePrice > 0 {
+			return errors.New("Dutch auctions use a floor price instead of a reserve")
+		}
+
+		if req.DutchPriceStep <= 0 {
+			return errors.New("Dutch price step must be positive")
+		}
+
+		if req.DutchFloorPrice <= 0 || req.DutchFloorPrice >= req.StartingBid {
+			return errors.New("Dutch floor price must be positive and below the starting bid")
+		}
+
+		// Price drops run through the scheduler, which polls for due jobs once a minute
+		if time.Duration(req.DutchStepIntervalSeconds)*time.Second < time.Minute {
+			return errors.New("Dutch step interval must be at least 60 seconds")
+		}
+	}
+
+	return nil
+}
+
+// validateCurrency checks that the currency is supported and that every amount
+// sits on its minor unit
+func (req *CreateAuctionRequest) validateCurrency() error {
+	code := req.Currency
+	if code == "" {
+		code = domain.DefaultCurrency
+	}
+	currency, err := domain.LookupCurrency(code)
+	if err != nil {
+		return fmt.Errorf("Unsupported currency %q", req.Currency)
+	}
+
+	amounts := []domain.Money{req.StartingBid, req.ReservePrice, req.BuyNowPrice, req.DutchPriceStep, req.DutchFloorPrice}
+	for _, tier := range req.IncrementTiers {
+		amounts = append(amounts, tier.From, tier.To, tier.Increment)
+	}
+	for _, amount := range amounts {
+		if currency.ValidateAmount(amount) != nil {
+			return fmt.Errorf("Amounts in %s must be multiples of %s", currency.Code, currency.Step())
+		}
+	}
+
+	return nil
+}
+
+func (req *CreateAuctionRequest) toAuction() *domain.Auction {
+	return &domain.Auction{
+		StartTime:         req.StartTime,
+		EndTime:           req.EndTime,
+		StartBid:          req.StartingBid,
+		Currency:          req.Currency,
+		Type:              req.auctionType(),
+		ReservePrice:      req.ReservePrice,
+		BuyNowPrice:       req.BuyNowPrice,
+		BuyNowUntil:       req.BuyNowUntil,
+		ItemID:            req.ItemID,
+		SellerID:          req.SellerID,
+		Title:             req.Title,
+		Category:          req.Category,
+		IncrementTiers:    req.IncrementTiers,
+		DutchPriceStep:    req.DutchPriceStep,
+		DutchStepInterval: time.Duration(req.DutchStepIntervalSeconds) * time.Second,
+		DutchFloorPrice:   req.DutchFloorPrice,
+
+		SoftCloseWindow:       time.Duration(req.SoftCloseWindowSeconds) * time.Second,
+		SoftCloseExtension:    time.Duration(req.SoftCloseExtensionSeconds) * time.Second,
+		SoftCloseMaxExtension: time.Duration(req.SoftCloseMaxExtensionSeconds) * time.Second,
+	}
+}
+
+// GetAuctionResponse is the stored auction plus its live bidding state
+type GetAuctionResponse struct {
+	AuctionResponse
+	Item                 *domain.ItemSummary `json:"item,omitempty"`
+	CurrentPrice         domain.Money        `json:"current_price"`
+	ReserveMet           bool                `json:"reserve_met"`
+	LeaderID             string              `json:"leader_id,omitempty"`
+	NextMinimumBid       domain.Money        `json:"next_minimum_bid"`
+	BidCount             int                 `json:"bid_count"`
+	TimeRemainingSeconds int64               `json:"time_remaining_seconds"`
+	Display              *DisplayPrices      `json:"display,omitempty"`
+}
+
+// DisplayPrices are the auction's prices converted into the currency the client
+// asked for with display_currency. They are for display only; bids are always
+// placed in the auction's own currency.
+type DisplayPrices struct {
+	Currency       string       `json:"currency"`
+	CurrentPrice   domain.Money `json:"current_price"`
+	NextMinimumBid domain.Money `json:"next_minimum_bid"`
+	BuyNowPrice    domain.Money `json:"buy_now_price,omitempty"`
+}
+
+// AuctionSummary is one entry of an auction listing
+type AuctionSummary struct {
+	AuctionID    string       `json:"auction_id"`
+	SellerID     string       `json:"seller_id"`
+	Title        string       `json:"title"`
+	Category     string       `json:"category"`
+	AuctionType  string       `json:"auction_type"`
+	Status       string       `json:"status"`
+	StartTime    time.Time    `json:"start_time"`
+	EndTime      time.Time    `json:"end_time"`
+	CurrentPrice domain.Money `json:"current_price"`
+	Currency     string       `json:"currency"`
+	DisplayPrice domain.Money `json:"display_price,omitempty"` // current_price in display_currency
+	SaleID       string       `json:"sale_id,omitempty"`
+	LotNumber    int          `json:"lot_number,omitempty"`
+
+	Item *domain.ItemSummary `json:"item,omitempty"`
+}
+
+func newAuctionSummary(auction *domain.Auction, item *domain.ItemSummary) AuctionSummary {
+	return AuctionSummary{
+		AuctionID:    auction.ID,
+		SellerID:     auction.SellerID,
+		Title:        auction.Title,
+		Category:     auction.Category,
+		AuctionType:  string(auction.Type),
+		Status:       auction.Status.String(),
+		StartTime:    auction.StartTime,
+		EndTime:      auction.EndTime,
+		CurrentPrice: auction.CurrentPrice,
+		Currency:     auction.Currency,
+		SaleID:       auction.SaleID,
+		LotNumber:    auction.LotNumber,
+		Item:         item,
+	}
+}
+
+type ListAuctionsResponse struct {
+	Auctions        []AuctionSummary `json:"auctions"`
+	DisplayCurrency string           `json:"display_currency,omitempty"`
+	NextCursor      string           `json:"next_cursor,omitempty"`
+}
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// StateChangeRequest is the optional body of the admin state-change endpoints
+type StateChangeRequest struct {
+	Actor  string `json:"actor"`
+	Reason string `json:"reason"`
+}
+
+type StatusTransitionResponse struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Actor     string    `json:"actor"`
+	Reason    string    `json:"reason"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func NewAuctionHandler(auctionManager *services.AuctionManager, currencyService *services.CurrencyService,
+	log logger.Logger) *AuctionHandler {
 	return &AuctionHandler{
-		auctionManager: auctionManager,
-		log:            log,
+		auctionManager:  auctionManager,
+		currencyService: currencyService,
+		log:             log,
 	}
 }
 
@@ -48,44 +340,273 @@ func (h *AuctionHandler) CreateAuction(c echo.Context) error {
 	}
 
 	// Validation
-	if req.StartTime.Before(time.Now()) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Start time must be in the future"})
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	if req.EndTime.Before(req.StartTime) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "End time must be after start time"})
+	auction, err := h.auctionManager.CreateAuction(c.Request().Context(), req.toAuction())
+	if errors.Is(err, domain.ErrItemNotFound) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Item not found"})
 	}
-
-	if req.StartingBid <= 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Starting bid must be positive"})
-	}
-
-	auction, err := h.auctionManager.CreateAuction(c.Request().Context(), req.StartTime, req.EndTime, req.StartingBid)
 	if err != nil {
 		h.log.Error("Failed to create auction", "error", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create auction"})
 	}
 
 	response := CreateAuctionResponse{
-		AuctionID:   auction.ID,
-		StartTime:   auction.StartTime,
-		EndTime:     auction.EndTime,
-		StartingBid: req.StartingBid,
-		Status:      auction.Status.String(),
+		AuctionResponse: newAuctionResponse(auction),
+		ReservePrice:    auction.ReservePrice,
 	}
 
 	h.log.Info("Auction created successfully", "auction_id", auction.ID)
 	return c.JSON(http.StatusCreated, response)
 }
 
+func newAuctionResponse(auction *domain.Auction) AuctionResponse {
+	return AuctionResponse{
+		AuctionID:                    auction.ID,
+		ItemID:                       auction.ItemID,
+		SaleID:                       auction.SaleID,
+		LotNumber:                    auction.LotNumber,
+		SellerID:                     auction.SellerID,
+		Title:                        auction.Title,
+		Category:                     auction.Category,
+		StartTime:                    auction.StartTime,
+		EndTime:                      auction.EndTime,
+		StartingBid:                  auction.StartBid,
+		Currency:                     auction.Currency,
+		AuctionType:                  string(auction.Type),
+		BuyNowPrice:                  auction.BuyNowPrice,
+		BuyNowUntil:                  auction.BuyNowUntil,
+		IncrementTiers:               auction.IncrementTiers,
+		DutchPriceStep:               auction.DutchPriceStep,
+		DutchStepIntervalSeconds:     int(auction.DutchStepInterval.Seconds()),
+		DutchFloorPrice:              auction.DutchFloorPrice,
+		SoftCloseWindowSeconds:       int(auction.SoftCloseWindow.Seconds()),
+		SoftCloseExtensionSeconds:    int(auction.SoftCloseExtension.Seconds()),
+		SoftCloseMaxExtensionSeconds: int(auction.SoftCloseMaxExtension.Seconds()),
+		Status:                       auction.Status.String(),
+	}
+}
+
 func (h *AuctionHandler) GetAuction(c echo.Context) error {
 	auctionID := c.Param("id")
 	h.log.Info("GetAuction endpoint called", "auction_id", auctionID)
 
-	return c.JSON(http.StatusOK, map[string]string{
-		"auction_id": auctionID,
-		"message":    "Auction details would be here",
-	})
+	details, err := h.auctionManager.GetAuctionDetails(c.Request().Context(), auctionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAuctionNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Auction not found"})
+		}
+		h.log.Error("Failed to load auction", "auction_id", auctionID, "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load auction"})
+	}
+
+	response := GetAuctionResponse{
+		AuctionResponse:      newAuctionResponse(details.Auction),
+		Item:                 details.Item,
+		CurrentPrice:         details.CurrentPrice,
+		ReserveMet:           details.CurrentPrice >= details.Auction.ReservePrice,
+		LeaderID:             details.LeaderID,
+		NextMinimumBid:       details.NextMinimumBid,
+		BidCount:             details.BidCount,
+		TimeRemainingSeconds: int64(details.TimeRemaining.Seconds()),
+	}
+	response.Status = details.Status.String()
+
+	if displayCurrency := c.QueryParam("display_currency"); displayCurrency != "" {
+		display, err := h.displayPrices(c.Request().Context(), details, displayCurrency)
+		if err != nil {
+			return h.conversionError(c, err)
+		}
+		response.Display = display
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *AuctionHandler) displayPrices(ctx context.Context, details *services.AuctionDetails,
+	displayCurrency string) (*DisplayPrices, error) {
+	from := details.Auction.Currency
+	display := &DisplayPrices{Currency: strings.ToUpper(displayCurrency)}
+
+	amounts := map[*domain.Money]domain.Money{
+		&display.CurrentPrice:   details.CurrentPrice,
+		&display.NextMinimumBid: details.NextMinimumBid,
+		&display.BuyNowPrice:    details.Auction.BuyNowPrice,
+	}
+	for dest, amount := range amounts {
+		converted, err := h.currencyService.ConvertForDisplay(ctx, amount, from, displayCurrency)
+		if err != nil {
+			return nil, err
+		}
+		*dest = converted
+	}
+
+	return display, nil
+}
+
+// conversionError maps a failed display conversion to a client-facing response
+func (h *AuctionHandler) conversionError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUnsupportedCurrency):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unsupported display currency"})
+	case errors.Is(err, domain.ErrExchangeRateNotFound):
+		return c.JSON(http.StatusUnprocessableEntity, map[string]string{"error": "No exchange rate for display currency"})
+	}
+	h.log.Error("Failed to convert prices", "error", err)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to convert prices"})
+}
+
+func (h *AuctionHandler) ListAuctions(c echo.Context) error {
+	h.log.Info("ListAuctions endpoint called", "query", c.QueryString())
+
+	filter, err := parseAuctionFilter(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	page, err := h.auctionManager.ListAuctions(c.Request().Context(), filter)
+	if err != nil {
+		h.log.Error("Failed to list auctions", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to list auctions"})
+	}
+
+	response := ListAuctionsResponse{Auctions: make([]AuctionSummary, 0, len(page.Auctions))}
+	displayCurrency := c.QueryParam("display_currency")
+	if displayCurrency != "" {
+		response.DisplayCurrency = strings.ToUpper(displayCurrency)
+	}
+
+	for _, auction := range page.Auctions {
+		summary := newAuctionSummary(auction, page.Items[auction.ItemID])
+		if displayCurrency != "" {
+			summary.DisplayPrice, err = h.currencyService.ConvertForDisplay(c.Request().Context(),
+				auction.CurrentPrice, auction.Currency, displayCurrency)
+			if err != nil {
+				return h.conversionError(c, err)
+			}
+		}
+		response.Auctions = append(response.Auctions, summary)
+	}
+
+	if page.Next != nil {
+		if response.NextCursor, err = encodeCursor(page.Next); err != nil {
+			h.log.Error("Failed to encode listing cursor", "error", err)
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to list auctions"})
+		}
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// parseAuctionFilter reads the listing query parameters and returns a client-facing error message
+func parseAuctionFilter(c echo.Context) (*domain.AuctionFilter, error) {
+	filter := &domain.AuctionFilter{
+		SellerID: c.QueryParam("seller_id"),
+		Category: c.QueryParam("category"),
+		Currency: strings.ToUpper(c.QueryParam("currency")),
+		Query:    strings.TrimSpace(c.QueryParam("q")),
+		Sort:     domain.SortEndingSoon,
+		Limit:    defaultListLimit,
+	}
+
+	if statuses := c.QueryParam("status"); statuses != "" {
+		for _, name := range strings.Split(statuses, ",") {
+			status, ok := domain.ParseAuctionStatus(strings.TrimSpace(name))
+			if !ok {
+				return nil, fmt.Errorf("Unknown status %q", name)
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	times := map[string]*time.Time{
+		"starts_after":  &filter.StartsAfter,
+		"starts_before": &filter.StartsBefore,
+		"ends_after":    &filter.EndsAfter,
+		"ends_before":   &filter.EndsBefore,
+	}
+	for param, dest := range times {
+		if value := c.QueryParam(param); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be an RFC 3339 timestamp", param)
+			}
+			*dest = t
+		}
+	}
+
+	prices := map[string]*domain.Money{
+		"min_price": &filter.MinPrice,
+		"max_price": &filter.MaxPrice,
+	}
+	pricesUsed := false
+	for param, dest := range prices {
+		if value := c.QueryParam(param); value != "" {
+			pricesUsed = true
+			price, err := domain.ParseMoney(value)
+			if err != nil || price < 0 {
+				return nil, fmt.Errorf("%s must be a non-negative amount with at most two decimals", param)
+			}
+			*dest = price
+		}
+	}
+	if filter.MaxPrice > 0 && filter.MinPrice > filter.MaxPrice {
+		return nil, errors.New("min_price must not be above max_price")
+	}
+
+	if sort := c.QueryParam("sort"); sort != "" {
+		filter.Sort = domain.AuctionSort(sort)
+		if !filter.Sort.IsValid() {
+			return nil, errors.New("sort must be one of ending_soon, highest_price, newest")
+		}
+	}
+
+	// Prices are stored in each auction's minor units, which only compare within one currency
+	if filter.Currency == "" && (pricesUsed || filter.Sort == domain.SortHighestPrice) {
+		return nil, errors.New("currency is required with min_price, max_price or sort=highest_price")
+	}
+
+	if value := c.QueryParam("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 || limit > maxListLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", maxListLimit)
+		}
+		filter.Limit = limit
+	}
+
+	if value := c.QueryParam("cursor"); value != "" {
+		cursor, err := decodeCursor(value)
+		if err != nil || cursor.Sort != filter.Sort {
+			return nil, errors.New("Invalid cursor")
+		}
+		filter.After = cursor
+	}
+
+	return filter, nil
+}
+
+// Cursors are opaque to clients: base64-encoded JSON of the last auction's sort key
+func encodeCursor(cursor *domain.AuctionCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(value string) (*domain.AuctionCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor domain.AuctionCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
 }
 
 func (h *AuctionHandler) ExtendAuction(c echo.Context) error {
@@ -98,9 +619,20 @@ func (h *AuctionHandler) ExtendAuction(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Extension duration required"})
 	}
 
-	extensionDuration := 30 * time.Second
-	if err := h.auctionManager.CheckAndExtendAuction(c.Request().Context(), auctionID, extensionDuration); err != nil {
-		h.log.Error("Failed to extend auction", "error", err)
+	seconds, err := strconv.Atoi(extensionStr)
+	if err != nil || seconds <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Extension must be a positive number of seconds"})
+	}
+
+	extensionDuration := time.Duration(seconds) * time.Second
+	if err := h.auctionManager.ExtendAuction(c.Request().Context(), auctionID, extensionDuration); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAuctionNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Auction not found"})
+		case errors.Is(err, domain.ErrInvalidStateTransition):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		h.log.Error("Failed to extend auction", "auction_id", auctionID, "error", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to extend auction"})
 	}
 
@@ -108,3 +640,80 @@ func (h *AuctionHandler) ExtendAuction(c echo.Context) error {
 		"message": "Auction extended successfully",
 	})
 }
+
+func (h *AuctionHandler) CancelAuction(c echo.Context) error {
+	return h.changeAuctionState(c, "cancel", "cancelled", h.auctionManager.CancelAuction)
+}
+
+func (h *AuctionHandler) PauseAuction(c echo.Context) error {
+	return h.changeAuctionState(c, "pause", "paused", h.auctionManager.PauseAuction)
+}
+
+func (h *AuctionHandler) ResumeAuction(c echo.Context) error {
+	return h.changeAuctionState(c, "resume", "resumed", h.auctionManager.ResumeAuction)
+}
+
+func (h *AuctionHandler) SettleAuction(c echo.Context) error {
+	return h.changeAuctionState(c, "settle", "settled", h.auctionManager.SettleAuction)
+}
+
+func (h *AuctionHandler) GetAuctionHistory(c echo.Context) error {
+	auctionID := c.Param("id")
+	h.log.Info("GetAuctionHistory endpoint called", "auction_id", auctionID)
+
+	history, err := h.auctionManager.GetStatusHistory(c.Request().Context(), auctionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAuctionNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Auction not found"})
+		}
+		h.log.Error("Failed to load auction history", "auction_id", auctionID, "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load auction history"})
+	}
+
+	transitions := make([]StatusTransitionResponse, 0, len(history))
+	for _, t := range history {
+		transitions = append(transitions, StatusTransitionResponse{
+			From:      t.From.String(),
+			To:        t.To.String(),
+			Actor:     t.Actor,
+			Reason:    t.Reason,
+			Timestamp: t.Timestamp,
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"auction_id":  auctionID,
+		"transitions": transitions,
+	})
+}
+
+func (h *AuctionHandler) changeAuctionState(c echo.Context, action, result string,
+	apply func(ctx context.Context, auctionID, actor, reason string) error) error {
+	auctionID := c.Param("id")
+
+	var req StateChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+	if req.Actor == "" {
+		req.Actor = "admin"
+	}
+
+	h.log.Info("Auction state change requested", "auction_id", auctionID, "action", action, "actor", req.Actor)
+
+	if err := apply(c.Request().Context(), auctionID, req.Actor, req.Reason); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAuctionNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Auction not found"})
+		case errors.Is(err, domain.ErrInvalidStateTransition):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		h.log.Error("Failed to change auction state", "auction_id", auctionID, "action", action, "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to " + action + " auction"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"auction_id": auctionID,
+		"message":    "Auction " + result,
+	})
+}
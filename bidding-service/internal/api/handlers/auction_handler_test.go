@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"auction-system/internal/domain"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursors := []*domain.AuctionCursor{
+		{Sort: domain.SortEndingSoon, ID: "auction_1", EndTime: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)},
+		{Sort: domain.SortHighestPrice, ID: "auction_2", Price: 123456},
+		{Sort: domain.SortNewest, ID: "auction_3", CreatedAt: time.Date(2026, 10, 1, 8, 30, 15, 0, time.UTC)},
+	}
+
+	for _, cursor := range cursors {
+		encoded, err := encodeCursor(cursor)
+		if err != nil {
+			t.Fatalf("encodeCursor(%+v): %v", cursor, err)
+		}
+
+		got, err := decodeCursor(encoded)
+		if err != nil {
+			t.Errorf("decodeCursor(%q): %v", encoded, err)
+			continue
+		}
+		if *got != *cursor {
+			t.Errorf("decodeCursor(encodeCursor(%+v)) = %+v", cursor, got)
+		}
+	}
+}
+
+func TestDecodeCursorRejectsMalformed(t *testing.T) {
+	values := []string{
+		"not base64!",
+		base64.StdEncoding.EncodeToString([]byte(`{"sort":"newest","id":"a"}`)), // padded, not URL encoding
+		base64.RawURLEncoding.EncodeToString([]byte(`{"sort":`)),
+		base64.RawURLEncoding.EncodeToString([]byte(`["newest"]`)),
+	}
+
+	for _, value := range values {
+		if cursor, err := decodeCursor(value); err == nil {
+			t.Errorf("decodeCursor(%q) = %+v; want an error", value, cursor)
+		}
+	}
+}
+
+func TestParseAuctionFilter(t *testing.T) {
+	newestCursor, _ := encodeCursor(&domain.AuctionCursor{Sort: domain.SortNewest, ID: "auction_1"})
+
+	tests := []struct {
+		query   string
+		wantErr bool
+	}{
+		{query: ""},
+		{query: "status=active,ended&sort=newest&limit=10"},
+
+		// Prices are only comparable within one currency
+		{query: "min_price=10", wantErr: true},
+		{query: "max_price=10.50", wantErr: true},
+		{query: "sort=highest_price", wantErr: true},
+		{query: "min_price=10&currency=usd"},
+		{query: "max_price=10.50&currency=JPY"},
+		{query: "sort=highest_price&currency=EUR"},
+		{query: "currency=GBP"},
+
+		{query: "min_price=20&max_price=10&currency=USD", wantErr: true},
+		{query: "min_price=10.001&currency=USD", wantErr: true},
+		{query: "status=unknown", wantErr: true},
+		{query: "sort=cheapest", wantErr: true},
+		{query: "limit=0", wantErr: true},
+		{query: "ends_before=tomorrow", wantErr: true},
+
+		// A cursor only continues the sort order it came from
+		{query: "sort=newest&cursor=" + newestCursor},
+		{query: "sort=ending_soon&cursor=" + newestCursor, wantErr: true},
+		{query: "cursor=garbage", wantErr: true},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/auctions?"+tt.query, nil)
+		c := e.NewContext(req, httptest.NewRecorder())
+
+		filter, err := parseAuctionFilter(c)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseAuctionFilter(%q) = %+v, %v; want error %v", tt.query, filter, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/services"
+	"auction-system/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+const maxItemImages = 20
+
+type ItemHandler struct {
+	catalog *services.CatalogService
+	log     logger.Logger
+}
+
+// ItemRequest is the body of both create and update; the seller is only read on create
+type ItemRequest struct {
+	SellerID    string            `json:"seller_id"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Condition   string            `json:"condition"`
+	CategoryID  string            `json:"category_id"`
+	Images      []string          `json:"images"`
+	Attributes  map[string]string `json:"attributes"`
+}
+
+type ItemResponse struct {
+	ItemID      string            `json:"item_id"`
+	SellerID    string            `json:"seller_id"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Condition   string            `json:"condition"`
+	CategoryID  string            `json:"category_id,omitempty"`
+	Images      []string          `json:"images"`
+	Attributes  map[string]string `json:"attributes"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+type CategoryRequest struct {
+	ParentID string `json:"parent_id"`
+	Name     string `json:"name"`
+}
+
+// CategoryNode is a category with its subcategories
+type CategoryNode struct {
+	*domain.Category
+	Children []*CategoryNode `json:"children"`
+}
+
+// Validate checks the request and returns a client-facing error message
+func (req *ItemRequest) Validate() error {
+	if req.Title == "" || len(req.Title) > 255 {
+		return errors.New("Title is required and must be at most 255 characters")
+	}
+
+	if !domain.ItemCondition(req.Condition).IsValid() {
+		return errors.New("Condition must be one of new, like_new, used, refurbished, for_parts")
+	}
+
+	if len(req.Images) > maxItemImages {
+		return errors.New("An item can have at most 20 images")
+	}
+	for _, image := range req.Images {
+		if image == "" {
+			return errors.New("Image references must not be empty")
+		}
+	}
+
+	return nil
+}
+
+func (req *ItemRequest) toItem() *domain.Item {
+	return &domain.Item{
+		SellerID:    req.SellerID,
+		Title:       req.Title,
+		Description: req.Description,
+		Condition:   domain.ItemCondition(req.Condition),
+		CategoryID:  req.CategoryID,
+		Images:      req.Images,
+		Attributes:  req.Attributes,
+	}
+}
+
+func newItemResponse(item *domain.Item) ItemResponse {
+	response := ItemResponse{
+		ItemID:      item.ID,
+		SellerID:    item.SellerID,
+		Title:       item.Title,
+		Description: item.Description,
+		Condition:   string(item.Condition),
+		CategoryID:  item.CategoryID,
+		Images:      item.Images,
+		Attributes:  item.Attributes,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+	if response.Images == nil {
+		response.Images = []string{}
+	}
+	if response.Attributes == nil {
+		response.Attributes = map[string]string{}
+	}
+	return response
+}
+
+func NewItemHandler(catalog *services.CatalogService, log logger.Logger) *ItemHandler {
+	return &ItemHandler{
+		catalog: catalog,
+		log:     log,
+	}
+}
+
+func (h *ItemHandler) CreateItem(c echo.Context) error {
+	var req ItemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	if req.SellerID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Seller is required"})
+	}
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	item, err := h.catalog.CreateItem(c.Request().Context(), req.toItem())
+	if err != nil {
+		return h.itemError(c, "create", err)
+	}
+
+	return c.JSON(http.StatusCreated, newItemResponse(item))
+}
+
+func (h *ItemHandler) GetItem(c echo.Context) error {
+	item, err := h.catalog.GetItem(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return h.itemError(c, "load", err)
+	}
+
+	return c.JSON(http.StatusOK, newItemResponse(item))
+}
+
+func (h *ItemHandler) UpdateItem(c echo.Context) error {
+	var req ItemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	item := req.toItem()
+	item.ID = c.Param("id")
+
+	item, err := h.catalog.UpdateItem(c.Request().Context(), item)
+	if err != nil {
+		return h.itemError(c, "update", err)
+	}
+
+	return c.JSON(http.StatusOK, newItemResponse(item))
+}
+
+func (h *ItemHandler) DeleteItem(c echo.Context) error {
+	if err := h.catalog.DeleteItem(c.Request().Context(), c.Param("id")); err != nil {
+		return h.itemError(c, "delete", err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *ItemHandler) CreateCategory(c echo.Context) error {
+	var req CategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	if req.Name == "" || len(req.Name) > 255 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Name is required and must be at most 255 characters"})
+	}
+
+	category, err := h.catalog.CreateCategory(c.Request().Context(),
+		&domain.Category{ParentID: req.ParentID, Name: req.Name})
+	if err != nil {
+		return h.itemError(c, "create category", err)
+	}
+
+	return c.JSON(http.StatusCreated, category)
+}
+
+// ListCategories returns the category tree, top-level categories first
+func (h *ItemHandler) ListCategories(c echo.Context) error {
+	categories, err := h.catalog.ListCategories(c.Request().Context())
+	if err != nil {
+		return h.itemError(c, "list categories", err)
+	}
+
+	nodes := make(map[string]*CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &CategoryNode{Category: category, Children: []*CategoryNode{}}
+	}
+
+	roots := []*CategoryNode{}
+	for _, category := range categories {
+		node := nodes[category.ID]
+		if parent, ok := nodes[category.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{"categories": roots})
+}
+
+func (h *ItemHandler) itemError(c echo.Context, action string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrItemNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Item not found"})
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Category not found"})
+	case errors.Is(err, domain.ErrItemInUse):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+
+	h.log.Error("Catalog request failed", "action", action, "error", err)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to " + action})
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/services"
+	"auction-system/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+const maxSaleLots = 1000
+
+type SaleHandler struct {
+	saleManager *services.SaleManager
+	log         logger.Logger
+}
+
+// CreateSaleRequest describes a catalogue sale. Lots take their start and end
+// times from the sale, so start_time and end_time are ignored on each lot.
+type CreateSaleRequest struct {
+	Title              string                 `json:"title"`
+	StartTime          time.Time              `json:"start_time"`
+	FirstLotClose      time.Time              `json:"first_lot_close"`
+	LotIntervalSeconds int                    `json:"lot_interval_seconds"`
+	Lots               []CreateAuctionRequest `json:"lots"`
+}
+
+type SaleResponse struct {
+	SaleID             string           `json:"sale_id"`
+	Title              string           `json:"title"`
+	StartTime          time.Time        `json:"start_time"`
+	FirstLotClose      time.Time        `json:"first_lot_close"`
+	LotIntervalSeconds int              `json:"lot_interval_seconds"`
+	Status             string           `json:"status"`
+	Lots               []AuctionSummary `json:"lots"`
+}
+
+// Validate checks the request and returns a client-facing error message
+func (req *CreateSaleRequest) Validate() error {
+	if req.Title == "" || len(req.Title) > 255 {
+		return errors.New("Title is required and must be at most 255 characters")
+	}
+
+	if req.StartTime.Before(time.Now()) {
+		return errors.New("Start time must be in the future")
+	}
+
+	if !req.FirstLotClose.After(req.StartTime) {
+		return errors.New("First lot close must be after the start time")
+	}
+
+	if req.LotIntervalSeconds <= 0 {
+		return errors.New("Lot interval must be positive")
+	}
+
+	if len(req.Lots) == 0 || len(req.Lots) > maxSaleLots {
+		return fmt.Errorf("A sale needs between 1 and %d lots", maxSaleLots)
+	}
+
+	sale := req.toSale()
+	for i := range req.Lots {
+		lot := &req.Lots[i]
+		lot.StartTime = sale.StartTime
+		lot.EndTime = sale.LotEndTime(i + 1)
+		if err := lot.Validate(); err != nil {
+			return fmt.Errorf("Lot %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func (req *CreateSaleRequest) toSale() *domain.Sale {
+	return &domain.Sale{
+		Title:         req.Title,
+		StartTime:     req.StartTime,
+		FirstLotClose: req.FirstLotClose,
+		LotInterval:   time.Duration(req.LotIntervalSeconds) * time.Second,
+	}
+}
+
+func newSaleResponse(sale *domain.Sale, lots []*domain.Auction) SaleResponse {
+	response := SaleResponse{
+		SaleID:             sale.ID,
+		Title:              sale.Title,
+		StartTime:          sale.StartTime,
+		FirstLotClose:      sale.FirstLotClose,
+		LotIntervalSeconds: int(sale.LotInterval.Seconds()),
+		Status:             string(sale.Status),
+		Lots:               make([]AuctionSummary, 0, len(lots)),
+	}
+	for _, lot := range lots {
+		response.Lots = append(response.Lots, newAuctionSummary(lot, nil))
+	}
+	return response
+}
+
+func NewSaleHandler(saleManager *services.SaleManager, log logger.Logger) *SaleHandler {
+	return &SaleHandler{
+		saleManager: saleManager,
+		log:         log,
+	}
+}
+
+func (h *SaleHandler) CreateSale(c echo.Context) error {
+	var req CreateSaleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	lots := make([]*domain.Auction, len(req.Lots))
+	for i := range req.Lots {
+		lots[i] = req.Lots[i].toAuction()
+	}
+
+	sale, lots, err := h.saleManager.CreateSale(c.Request().Context(), req.toSale(), lots)
+	if errors.Is(err, domain.ErrItemNotFound) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if err != nil {
+		h.log.Error("Failed to create sale", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create sale"})
+	}
+
+	h.log.Info("Sale created successfully", "sale_id", sale.ID)
+	return c.JSON(http.StatusCreated, newSaleResponse(sale, lots))
+}
+
+func (h *SaleHandler) GetSale(c echo.Context) error {
+	sale, lots, err := h.saleManager.GetSale(c.Request().Context(), c.Param("id"))
+	if errors.Is(err, domain.ErrSaleNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Sale not found"})
+	}
+	if err != nil {
+		h.log.Error("Failed to load sale", "sale_id", c.Param("id"), "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load sale"})
+	}
+
+	return c.JSON(http.StatusOK, newSaleResponse(sale, lots))
+}
+
+func (h *SaleHandler) CancelSale(c echo.Context) error {
+	return h.changeSaleState(c, "cancel", "cancelled", h.saleManager.CancelSale)
+}
+
+func (h *SaleHandler) PauseSale(c echo.Context) error {
+	return h.changeSaleState(c, "pause", "paused", h.saleManager.PauseSale)
+}
+
+func (h *SaleHandler) ResumeSale(c echo.Context) error {
+	return h.changeSaleState(c, "resume", "resumed", h.saleManager.ResumeSale)
+}
+
+func (h *SaleHandler) changeSaleState(c echo.Context, action, result string,
+	apply func(ctx context.Context, saleID, actor, reason string) error) error {
+	saleID := c.Param("id")
+
+	var req StateChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+	if req.Actor == "" {
+		req.Actor = "admin"
+	}
+
+	h.log.Info("Sale state change requested", "sale_id", saleID, "action", action, "actor", req.Actor)
+
+	if err := apply(c.Request().Context(), saleID, req.Actor, req.Reason); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSaleNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Sale not found"})
+		case errors.Is(err, domain.ErrInvalidStateTransition):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		h.log.Error("Failed to change sale state", "sale_id", saleID, "action", action, "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to " + action + " sale"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"sale_id": saleID,
+		"message": "Sale " + result,
+	})
+}
@@ -5,13 +5,71 @@ import (
 )
 
 type Auction struct {
-	ID        string
-	StartTime time.Time
-	EndTime   time.Time
-	StartBid  float64
-	Status    AuctionStatus
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID           string
+	StartTime    time.Time
+	EndTime      time.Time
+	StartBid     Money
+	Currency     string // ISO 4217 code; every amount of the auction is in this currency
+	Type         AuctionType
+	ReservePrice Money     // hidden from bidders, 0 means no reserve
+	BuyNowPrice  Money     // 0 means buy-it-now is not offered
+	BuyNowUntil  time.Time // zero means buy-it-now stays available until the auction ends
+	Status       AuctionStatus
+	PausedAt     time.Time // set while the auction is paused
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	// Listing details used to search for auctions. Title and Category are
+	// copied from the linked item when there is one.
+	ItemID       string
+	SellerID     string
+	Title        string
+	Category     string
+	CurrentPrice Money // last visible price, kept in sync from bid events
+
+	// Lots of a catalogue sale; SaleID is empty for standalone auctions
+	SaleID    string
+	LotNumber int
+
+	// IncrementTiers are stored with the auction's bidding rules, not on the auctions table
+	IncrementTiers []IncrementTier
+
+	// Soft close: a bid within SoftCloseWindow of EndTime pushes the end to
+	// SoftCloseExtension after the bid, at most SoftCloseMaxExtension past
+	// OriginalEndTime (0 means uncapped). A zero window disables soft close.
+	OriginalEndTime       time.Time
+	SoftCloseWindow       time.Duration
+	SoftCloseExtension    time.Duration
+	SoftCloseMaxExtension time.Duration
+
+	// Dutch auctions start at StartBid and drop by DutchPriceStep every
+	// DutchStepInterval until someone accepts or DutchFloorPrice is reached
+	DutchPriceStep    Money
+	DutchStepInterval time.Duration
+	DutchFloorPrice   Money
+}
+
+type AuctionType string
+
+const (
+	AuctionEnglish           AuctionType = "english"
+	AuctionSealedFirstPrice  AuctionType = "sealed_first_price"
+	AuctionSealedSecondPrice AuctionType = "sealed_second_price"
+	AuctionDutch             AuctionType = "dutch"
+)
+
+func (t AuctionType) IsValid() bool {
+	switch t {
+	case AuctionEnglish, AuctionSealedFirstPrice, AuctionSealedSecondPrice, AuctionDutch:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSealed reports whether bids are kept private until the auction ends
+func (t AuctionType) IsSealed() bool {
+	return t == AuctionSealedFirstPrice || t == AuctionSealedSecondPrice
 }
 
 type AuctionStatus int
@@ -21,6 +79,8 @@ const (
 	AuctionActive
 	AuctionEnded
 	AuctionCancelled
+	AuctionPaused
+	AuctionSettled
 )
 
 func (s AuctionStatus) String() string {
@@ -33,25 +93,64 @@ func (s AuctionStatus) String() string {
 		return "ended"
 	case AuctionCancelled:
 		return "cancelled"
+	case AuctionPaused:
+		return "paused"
+	case AuctionSettled:
+		return "settled"
 	default:
 		return "unknown"
 	}
 }
 
+// ParseAuctionStatus returns the status with the given name
+func ParseAuctionStatus(name string) (AuctionStatus, bool) {
+	for s := AuctionPending; s <= AuctionSettled; s++ {
+		if s.String() == name {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
 type LocalAuctionCache struct {
 	AuctionID     string
-	CurrentBid    float64
+	AuctionType   AuctionType
+	CurrentBid    Money
+	Currency      string
 	WinnerID      string
-	IncrementRule float64
+	IncrementRule Money
+	ReservePrice  Money
+	EndTime       time.Time
+	BidCount      int
+	SaleID        string
+	Sequence      int64 // of the last event reflected in the state
 	LastUpdated   time.Time
 }
 
+// IsLeading reports whether userID leads the auction. The leader of a sealed
+// auction is never revealed, not even to the leader.
+func (c *LocalAuctionCache) IsLeading(userID string) bool {
+	return !c.AuctionType.IsSealed() && c.WinnerID != "" && c.WinnerID == userID
+}
+
+// NextMinimumBid is the lowest amount a new bid can be placed at
+func (c *LocalAuctionCache) NextMinimumBid() Money {
+	if c.AuctionType.IsSealed() || c.AuctionType == AuctionDutch {
+		return c.CurrentBid
+	}
+	return c.CurrentBid + c.IncrementRule
+}
+
 type BidEvent struct {
+	EventID   string       `json:"event_id,omitempty"` // empty for events in the legacy colon-delimited format
+	Sequence  int64        `json:"sequence,omitempty"` // position in the auction's event sequence, 0 if not sequenced
 	Type      BidEventType `json:"type"`
 	AuctionID string       `json:"auction_id"`
 	UserID    string       `json:"user_id"`
-	Amount    float64      `json:"amount"`
+	Amount    Money        `json:"amount"`
 	Timestamp time.Time    `json:"timestamp"`
+	BidID     string       `json:"bid_id,omitempty"` // bid ID of the bid that caused the event
+	EndTime   time.Time    `json:"end_time"`         // new end time of auction_extended, zero otherwise
 }
 
 type BidEventType string
@@ -60,11 +159,40 @@ const (
 	BidAccepted             BidEventType = "bid_accepted"
 	BidRejected             BidEventType = "bid_rejected"
 	AuctionEndedBidRejected BidEventType = "auction_ended"
+	AuctionReserveNotMet    BidEventType = "ended_reserve_not_met"
+	BuyNowExecuted          BidEventType = "buy_now"
+	SealedBidPlaced         BidEventType = "sealed_bid"
+	DutchPriceDropped       BidEventType = "price_dropped"
+	DutchPriceAccepted      BidEventType = "dutch_accepted"
+	AuctionCancelledEvent   BidEventType = "auction_cancelled"
+	AuctionPausedEvent      BidEventType = "auction_paused"
+	AuctionResumedEvent     BidEventType = "auction_resumed"
 	AuctionExtended         BidEventType = "auction_extended"
+	// BidRetracted carries the leader and price after the replay; BidID is the retracted bid's
+	BidRetracted BidEventType = "bid_retracted"
+	// BidRateLimited records the first bid the rate limiter turned away from a
+	// bidder on an auction each minute, for analytics only
+	BidRateLimited BidEventType = "rate_limited"
+	// EventsMissed is never published. A subscriber hands it to its handler after
+	// an event that shows earlier ones of the auction were lost; Sequence is that event's.
+	EventsMissed BidEventType = "events_missed"
 )
 
-type BidValidationRules struct {
-	Rules map[string]float64 `json:"rules"`
+// Sequenced reports whether events of the type are numbered in their auction's
+// event sequence. Those about a single bidder's bid, which not everyone
+// watching the auction is told about, are not.
+func (t BidEventType) Sequenced() bool {
+	switch t {
+	case BidRejected, SealedBidPlaced, BidRateLimited, EventsMissed:
+		return false
+	}
+	return true
+}
+
+type SealedBid struct {
+	UserID   string
+	Amount   Money
+	PlacedAt time.Time
 }
 
 type ScheduledJob struct {
@@ -81,6 +209,7 @@ type JobType string
 const (
 	JobStartAuction JobType = "start_auction"
 	JobEndAuction   JobType = "end_auction"
+	JobPriceDrop    JobType = "price_drop"
 )
 
 type JobStatus string
@@ -1,6 +1,10 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"time"
+)
 
 // Event interfaces
 type EventPublisher interface {
@@ -12,3 +16,57 @@ type EventSubscriber interface {
 }
 
 type EventHandler func(event *BidEvent) error
+
+// EventSchemaVersion is the envelope version this build produces and the
+// newest it can read. Adding a field does not need a new version; changing the
+// meaning of one does.
+const EventSchemaVersion = 1
+
+// EventEnvelope is the JSON every producer publishes; the redis package builds
+// it in Lua for EventPublisher and the bid scripts alike. Sequence numbers an
+// auction's events from 1 up without gaps, or is 0 for event types that are not
+// Sequenced.
+type EventEnvelope struct {
+	EventID       string          `json:"event_id"`
+	SchemaVersion int             `json:"schema_version"`
+	Type          BidEventType    `json:"type"`
+	AuctionID     string          `json:"auction_id"`
+	Sequence      int64           `json:"sequence"`
+	Producer      string          `json:"producer"`
+	Payload       BidEventPayload `json:"payload"`
+}
+
+// BidEventPayload is the body of a bid event. Timestamp and EndTime are in Unix
+// seconds; EndTime is only set on auction_extended.
+type BidEventPayload struct {
+	UserID    string `json:"user_id"`
+	Amount    Money  `json:"amount"`
+	Timestamp int64  `json:"timestamp"`
+	BidID     string `json:"bid_id"`
+	EndTime   int64  `json:"end_time,omitempty"`
+}
+
+// BidEvent unwraps the envelope, refusing versions this build does not know
+func (e *EventEnvelope) BidEvent() (*BidEvent, error) {
+	if e.SchemaVersion < 1 || e.SchemaVersion > EventSchemaVersion {
+		return nil, fmt.Errorf("%w: version %d", ErrUnsupportedEventSchema, e.SchemaVersion)
+	}
+	if e.AuctionID == "" || e.Type == "" {
+		return nil, fmt.Errorf("event %s has no auction ID or type", e.EventID)
+	}
+
+	event := &BidEvent{
+		EventID:   e.EventID,
+		Sequence:  e.Sequence,
+		Type:      e.Type,
+		AuctionID: e.AuctionID,
+		UserID:    e.Payload.UserID,
+		Amount:    e.Payload.Amount,
+		Timestamp: time.Unix(e.Payload.Timestamp, 0),
+		BidID:     e.Payload.BidID,
+	}
+	if e.Payload.EndTime != 0 {
+		event.EndTime = time.Unix(e.Payload.EndTime, 0)
+	}
+	return event, nil
+}
@@ -0,0 +1,66 @@
+package domain
+
+import "time"
+
+type ItemCondition string
+
+const (
+	ConditionNew         ItemCondition = "new"
+	ConditionLikeNew     ItemCondition = "like_new"
+	ConditionUsed        ItemCondition = "used"
+	ConditionRefurbished ItemCondition = "refurbished"
+	ConditionForParts    ItemCondition = "for_parts"
+)
+
+func (c ItemCondition) IsValid() bool {
+	switch c {
+	case ConditionNew, ConditionLikeNew, ConditionUsed, ConditionRefurbished, ConditionForParts:
+		return true
+	}
+	return false
+}
+
+// Item is the lot an auction sells. An item can be relisted, so several
+// auctions may point at the same item.
+type Item struct {
+	ID          string
+	SellerID    string
+	Title       string
+	Description string
+	Condition   ItemCondition
+	CategoryID  string
+	Images      []string          // image URLs or storage references, the first is the cover image
+	Attributes  map[string]string // free-form details such as brand or size
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ItemSummary is the short form of an item shown alongside auctions
+type ItemSummary struct {
+	ID         string        `json:"id"`
+	Title      string        `json:"title"`
+	Condition  ItemCondition `json:"condition"`
+	CategoryID string        `json:"category_id"`
+	CoverImage string        `json:"cover_image,omitempty"`
+}
+
+func (i *Item) Summary() *ItemSummary {
+	summary := &ItemSummary{
+		ID:         i.ID,
+		Title:      i.Title,
+		Condition:  i.Condition,
+		CategoryID: i.CategoryID,
+	}
+	if len(i.Images) > 0 {
+		summary.CoverImage = i.Images[0]
+	}
+	return summary
+}
+
+// Category is a node of the category tree; top-level categories have no parent
+type Category struct {
+	ID        string    `json:"id"`
+	ParentID  string    `json:"parent_id,omitempty"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+type AuctionSort string
+
+const (
+	SortEndingSoon   AuctionSort = "ending_soon"
+	SortHighestPrice AuctionSort = "highest_price"
+	SortNewest       AuctionSort = "newest"
+)
+
+func (s AuctionSort) IsValid() bool {
+	switch s {
+	case SortEndingSoon, SortHighestPrice, SortNewest:
+		return true
+	}
+	return false
+}
+
+// AuctionFilter narrows an auction listing. Zero values leave a filter unset.
+type AuctionFilter struct {
+	Statuses     []AuctionStatus
+	StartsAfter  time.Time
+	StartsBefore time.Time
+	EndsAfter    time.Time
+	EndsBefore   time.Time
+	Currency     string // prices only compare within one currency
+	MinPrice     Money
+	MaxPrice     Money
+	SellerID     string
+	Category     string
+	Query        string // free-text search on the title
+
+	Sort  AuctionSort
+	Limit int
+	After *AuctionCursor // continue after this auction, nil for the first page
+}
+
+// AuctionCursor identifies the last auction of a page by its sort key and ID,
+// so the next page stays stable while new auctions are created
+type AuctionCursor struct {
+	Sort      AuctionSort `json:"sort"`
+	ID        string      `json:"id"`
+	EndTime   time.Time   `json:"end_time,omitempty"`
+	Price     Money       `json:"price,omitempty"`
+	CreatedAt time.Time   `json:"created_at,omitempty"`
+}
+
+// CursorFor returns the cursor pointing just past auction in the given sort order
+func CursorFor(sort AuctionSort, auction *Auction) *AuctionCursor {
+	return &AuctionCursor{
+		Sort:      sort,
+		ID:        auction.ID,
+		EndTime:   auction.EndTime,
+		Price:     auction.CurrentPrice,
+		CreatedAt: auction.CreatedAt,
+	}
+}
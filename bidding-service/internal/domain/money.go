@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount in minor units (cents). Amounts stay integers everywhere
+// they are compared or added, including Redis and the Lua scripts, and are
+// only written as decimals ("105.50") at the edges: JSON, events and MySQL.
+type Money int64
+
+var ErrInvalidMoney = errors.New("invalid amount")
+
+// ParseMoney parses a decimal amount such as "105", "105.5" or "105.50".
+// More than two decimal places is rejected rather than rounded.
+func ParseMoney(s string) (Money, error) {
+	negative := strings.HasPrefix(s, "-")
+	digits := strings.TrimPrefix(s, "-")
+
+	whole, frac, hasPoint := strings.Cut(digits, ".")
+	if whole == "" || len(frac) > 2 || (hasPoint && frac == "") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+
+	for _, part := range []string{whole, frac} {
+		for _, c := range part {
+			if c < '0' || c > '9' {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+			}
+		}
+	}
+
+	cents := int64(0)
+	if frac != "" {
+		cents, _ = strconv.ParseInt((frac + "0")[:2], 10, 64)
+	}
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units > (math.MaxInt64-cents)/100 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+
+	m := Money(units*100 + cents)
+	if negative {
+		m = -m
+	}
+	return m, nil
+}
+
+// String formats the amount with two decimal places
+func (m Money) String() string {
+	sign := ""
+	v := int64(m)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+// Percent returns percent % of the amount, rounded to the nearest minor unit
+func (m Money) Percent(percent float64) Money {
+	return Money(math.Round(float64(m) * percent / 100))
+}
+
+// MarshalJSON writes the amount as a decimal string so clients never see binary floating point
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + m.String() + `"`), nil
+}
+
+// UnmarshalJSON accepts both "105.50" and 105.50. Numbers are parsed from their
+// text, so they are exact as long as they have at most two decimal places.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	text := string(bytes.Trim(data, `"`))
+	parsed, err := ParseMoney(text)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value stores the amount in DECIMAL columns as an exact decimal string
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+		return nil
+	case []byte:
+		return m.scanDecimal(string(v))
+	case string:
+		return m.scanDecimal(v)
+	case int64:
+		*m = Money(v * 100)
+		return nil
+	case float64:
+		*m = Money(math.Round(v * 100))
+		return nil
+	}
+	return fmt.Errorf("cannot scan %T into Money", src)
+}
+
+// scanDecimal reads MySQL DECIMAL text, which may carry more than two decimals of zeros
+func (m *Money) scanDecimal(s string) error {
+	if whole, frac, ok := strings.Cut(s, "."); ok && len(frac) > 2 {
+		if strings.Trim(frac[2:], "0") != "" {
+			return fmt.Errorf("%w: %q has more than two decimal places", ErrInvalidMoney, s)
+		}
+		s = whole + "." + frac[:2]
+	}
+
+	parsed, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Money
+		wantErr bool
+	}{
+		{in: "0", want: 0},
+		{in: "105", want: 10500},
+		{in: "105.5", want: 10550},
+		{in: "105.50", want: 10550},
+		{in: "0.01", want: 1},
+		{in: "007.10", want: 710},
+		{in: "-5", want: -500},
+		{in: "-0.25", want: -25},
+		{in: "92233720368547758.07", want: math.MaxInt64},
+		{in: "-92233720368547758.07", want: -math.MaxInt64},
+
+		// More than two decimals is rejected, not rounded
+		{in: "105.505", wantErr: true},
+		{in: "0.001", wantErr: true},
+
+		{in: "92233720368547758.08", wantErr: true},
+		{in: "92233720368547759", wantErr: true},
+		{in: "99999999999999999999", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: ".5", wantErr: true},
+		{in: "5.", wantErr: true},
+		{in: "+5", wantErr: true},
+		{in: "--5", wantErr: true},
+		{in: "1,000", wantErr: true},
+		{in: "1e3", wantErr: true},
+		{in: " 5", wantErr: true},
+		{in: "5.-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMoney(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidMoney) {
+				t.Errorf("ParseMoney(%q) = %v, %v; want ErrInvalidMoney", tt.in, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseMoney(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		in   Money
+		want string
+	}{
+		{in: 0, want: "0.00"},
+		{in: 1, want: "0.01"},
+		{in: 10550, want: "105.50"},
+		{in: -25, want: "-0.25"},
+		{in: -10500, want: "-105.00"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("Money(%d).String() = %q; want %q", int64(tt.in), got, tt.want)
+		}
+	}
+}
+
+func TestCurrencyAmounts(t *testing.T) {
+	tests := []struct {
+		currency  string
+		amount    Money
+		wantValid bool
+		wantRound Money
+	}{
+		// Two minor units: every cent is a valid amount
+		{currency: "USD", amount: 10551, wantValid: true, wantRound: 10551},
+		{currency: "USD", amount: 1, wantValid: true, wantRound: 1},
+
+		// No minor units: only whole yen, rounded half away from zero
+		{currency: "JPY", amount: 10500, wantValid: true, wantRound: 10500},
+		{currency: "JPY", amount: 10550, wantValid: false, wantRound: 10600},
+		{currency: "JPY", amount: 10549, wantValid: false, wantRound: 10500},
+		{currency: "JPY", amount: -10550, wantValid: false, wantRound: -10600},
+		{currency: "KRW", amount: 1, wantValid: false, wantRound: 0},
+	}
+
+	for _, tt := range tests {
+		currency, err := LookupCurrency(tt.currency)
+		if err != nil {
+			t.Fatalf("LookupCurrency(%q): %v", tt.currency, err)
+		}
+
+		err = currency.ValidateAmount(tt.amount)
+		if (err == nil) != tt.wantValid {
+			t.Errorf("%s.ValidateAmount(%v) = %v; want valid %v", tt.currency, tt.amount, err, tt.wantValid)
+		}
+		if got := currency.Round(tt.amount); got != tt.wantRound {
+			t.Errorf("%s.Round(%v) = %v; want %v", tt.currency, tt.amount, got, tt.wantRound)
+		}
+	}
+}
+
+func TestCurrencyStep(t *testing.T) {
+	tests := []struct {
+		currency string
+		want     Money
+	}{
+		{currency: "USD", want: 1},
+		{currency: "EUR", want: 1},
+		{currency: "JPY", want: 100},
+		{currency: "krw", want: 100},
+	}
+
+	for _, tt := range tests {
+		currency, err := LookupCurrency(tt.currency)
+		if err != nil {
+			t.Fatalf("LookupCurrency(%q): %v", tt.currency, err)
+		}
+		if got := currency.Step(); got != tt.want {
+			t.Errorf("%s.Step() = %d; want %d", tt.currency, int64(got), int64(tt.want))
+		}
+	}
+
+	if _, err := LookupCurrency("BHD"); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("LookupCurrency(\"BHD\") = %v; want ErrUnsupportedCurrency", err)
+	}
+}
+
+func TestCurrencyRoundIncrement(t *testing.T) {
+	tests := []struct {
+		currency  string
+		increment Money
+		want      Money
+	}{
+		// Percentage increments come out in cents
+		{currency: "USD", increment: 1234, want: 1234},
+		{currency: "USD", increment: 0, want: 1},
+
+		// and are rounded to whole yen and won, never below one
+		{currency: "JPY", increment: 12345, want: 12300},
+		{currency: "JPY", increment: 12350, want: 12400},
+		{currency: "JPY", increment: 149, want: 100},
+		{currency: "JPY", increment: 49, want: 100},
+		{currency: "JPY", increment: 0, want: 100},
+		{currency: "KRW", increment: 250050, want: 250100},
+		{currency: "KRW", increment: 1, want: 100},
+	}
+
+	for _, tt := range tests {
+		currency, err := LookupCurrency(tt.currency)
+		if err != nil {
+			t.Fatalf("LookupCurrency(%q): %v", tt.currency, err)
+		}
+		if got := currency.RoundIncrement(tt.increment); got != tt.want {
+			t.Errorf("%s.RoundIncrement(%v) = %v; want %v", tt.currency, tt.increment, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyValue(t *testing.T) {
+	value, err := Money(-10550).Value()
+	if err != nil || value != "-105.50" {
+		t.Errorf("Value() = %v, %v; want \"-105.50\"", value, err)
+	}
+}
+
+func TestMoneyScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Money
+		wantErr bool
+	}{
+		{name: "nil", src: nil, want: 0},
+		{name: "decimal bytes", src: []byte("105.50"), want: 10550},
+		{name: "decimal string", src: "105.5", want: 10550},
+		{name: "trailing zeros", src: []byte("105.5000"), want: 10550},
+		{name: "negative", src: []byte("-0.25"), want: -25},
+		{name: "int64", src: int64(105), want: 10500},
+		{name: "float64 rounds", src: 105.5051, want: 10551},
+		{name: "float64 inexact", src: 0.29, want: 29},
+		{name: "extra precision", src: []byte("105.501"), wantErr: true},
+		{name: "garbage", src: []byte("abc"), wantErr: true},
+		{name: "overflow", src: []byte("92233720368547758.08"), wantErr: true},
+		{name: "unsupported type", src: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var got Money
+		err := got.Scan(tt.src)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: Scan(%v) = %v; want an error", tt.name, tt.src, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: Scan(%v) = %v, %v; want %v", tt.name, tt.src, got, err, tt.want)
+		}
+	}
+
+	// What Value stores, Scan reads back
+	for _, m := range []Money{0, 1, -25, 10550, math.MaxInt64} {
+		value, _ := m.Value()
+		var got Money
+		if err := got.Scan([]byte(value.(string))); err != nil || got != m {
+			t.Errorf("Scan(Value(%d)) = %v, %v", int64(m), got, err)
+		}
+	}
+}
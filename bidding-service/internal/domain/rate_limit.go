@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimit is a token bucket: up to Burst bids at once, refilled at Rate bids
+// per second. A zero Rate or Burst disables the limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l RateLimit) Enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+// RefillTime is how long an empty bucket takes to fill up again
+func (l RateLimit) RefillTime() time.Duration {
+	return time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+}
+
+// BidRateLimiter throttles bidders. Its buckets live outside the process so the
+// limits hold across all bidding-service replicas.
+type BidRateLimiter interface {
+	// AllowBid takes a token from the bidder's bucket and from their bucket for the
+	// auction, or none if either is empty. Denied bids are published as
+	// BidRateLimited events, at most one per bidder and auction a minute.
+	AllowBid(ctx context.Context, bid *BidRequest) (bool, error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"auction-system/internal/domain"
+	"context"
+)
+
+type RetractionRepository interface {
+	CreateRetraction(ctx context.Context, retraction *domain.BidRetraction) error
+	GetRetraction(ctx context.Context, retractionID string) (*domain.BidRetraction, error)
+	// ListRetractions returns the retractions of an auction, oldest first. An empty
+	// auctionID lists all auctions and an empty status lists every status.
+	ListRetractions(ctx context.Context, auctionID string, status domain.RetractionStatus) ([]*domain.BidRetraction, error)
+	// DecideRetraction stores retraction.Status and the decision only if the retraction
+	// is still in from, and returns domain.ErrRetractionDecided otherwise
+	DecideRetraction(ctx context.Context, retraction *domain.BidRetraction, from domain.RetractionStatus) error
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"auction-system/internal/domain"
+	"context"
+)
+
+type SaleRepository interface {
+	CreateSale(ctx context.Context, sale *domain.Sale) error
+	GetSale(ctx context.Context, saleID string) (*domain.Sale, error)
+	UpdateSaleStatus(ctx context.Context, saleID string, status domain.SaleStatus) error
+	// GetSaleLots returns the sale's auctions ordered by lot number
+	GetSaleLots(ctx context.Context, saleID string) ([]*domain.Auction, error)
+}
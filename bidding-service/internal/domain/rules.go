@@ -1,9 +1,77 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"errors"
+)
 
 type BiddingRule interface {
-	GetMinimumBid(currentAmount float64) float64
-	GetIncrementRule(amount float64) float64
+	GetMinimumBid(currentAmount Money) Money
+	GetIncrementRule(amount Money) Money
 	LoadRules(ctx context.Context) error
+	DefaultTiers() []IncrementTier
+	SaveAuctionRules(ctx context.Context, auctionID string, rules *BidValidationRules) error
+	GetAuctionRules(ctx context.Context, auctionID string) (*BidValidationRules, error)
+}
+
+// IncrementTier applies to prices in [From, To). A zero To leaves the tier open-ended.
+// Exactly one of Increment (fixed amount) or Percent (of the current price) is set.
+type IncrementTier struct {
+	From      Money   `json:"from"`
+	To        Money   `json:"to,omitempty"`
+	Increment Money   `json:"increment,omitempty"`
+	Percent   float64 `json:"percent,omitempty"`
+}
+
+type BidValidationRules struct {
+	Tiers []IncrementTier `json:"tiers"`
+}
+
+// Validate checks that the tiers start at 0, are contiguous and end with an open-ended tier
+func (r *BidValidationRules) Validate() error {
+	if len(r.Tiers) == 0 {
+		return errors.New("at least one increment tier is required")
+	}
+
+	for i, tier := range r.Tiers {
+		if (tier.Increment > 0) == (tier.Percent > 0) {
+			return errors.New("each increment tier needs either a positive increment or a positive percent")
+		}
+		if tier.Increment < 0 || tier.Percent < 0 {
+			return errors.New("increment tier values must not be negative")
+		}
+
+		if i == 0 && tier.From != 0 {
+			return errors.New("the first increment tier must start at 0")
+		}
+		if i > 0 && tier.From != r.Tiers[i-1].To {
+			return errors.New("increment tiers must be contiguous")
+		}
+
+		last := i == len(r.Tiers)-1
+		if last && tier.To != 0 {
+			return errors.New("the last increment tier must be open-ended")
+		}
+		if !last && tier.To <= tier.From {
+			return errors.New("increment tier upper bound must be above its lower bound")
+		}
+	}
+
+	return nil
+}
+
+func (r *BidValidationRules) GetIncrementRule(amount Money) Money {
+	for _, tier := range r.Tiers {
+		if amount >= tier.From && (tier.To == 0 || amount < tier.To) {
+			if tier.Percent > 0 {
+				return amount.Percent(tier.Percent)
+			}
+			return tier.Increment
+		}
+	}
+	return 500 // default
+}
+
+func (r *BidValidationRules) GetMinimumBid(currentAmount Money) Money {
+	return currentAmount + r.GetIncrementRule(currentAmount)
 }
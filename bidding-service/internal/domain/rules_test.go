@@ -0,0 +1,120 @@
+package domain
+
+import "testing"
+
+func TestBidValidationRulesValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tiers   []IncrementTier
+		wantErr bool
+	}{
+		{
+			name:  "single open-ended tier",
+			tiers: []IncrementTier{{From: 0, Increment: 500}},
+		},
+		{
+			name: "fixed and percent tiers",
+			tiers: []IncrementTier{
+				{From: 0, To: 10000, Increment: 500},
+				{From: 10000, To: 100000, Increment: 2000},
+				{From: 100000, Percent: 2.5},
+			},
+		},
+		{name: "no tiers", wantErr: true},
+
+		// Lower bound
+		{
+			name:    "first tier does not start at 0",
+			tiers:   []IncrementTier{{From: 100, Increment: 500}},
+			wantErr: true,
+		},
+		{
+			name: "gap between tiers",
+			tiers: []IncrementTier{
+				{From: 0, To: 10000, Increment: 500},
+				{From: 20000, Increment: 1000},
+			},
+			wantErr: true,
+		},
+		{
+			name: "overlapping tiers",
+			tiers: []IncrementTier{
+				{From: 0, To: 10000, Increment: 500},
+				{From: 5000, Increment: 1000},
+			},
+			wantErr: true,
+		},
+
+		// Upper bound
+		{
+			name:    "last tier is not open-ended",
+			tiers:   []IncrementTier{{From: 0, To: 10000, Increment: 500}},
+			wantErr: true,
+		},
+		{
+			name: "upper bound not above lower bound",
+			tiers: []IncrementTier{
+				{From: 0, To: 0, Increment: 500},
+				{From: 0, Increment: 1000},
+			},
+			wantErr: true,
+		},
+
+		// Step
+		{
+			name:    "neither increment nor percent",
+			tiers:   []IncrementTier{{From: 0}},
+			wantErr: true,
+		},
+		{
+			name:    "both increment and percent",
+			tiers:   []IncrementTier{{From: 0, Increment: 500, Percent: 5}},
+			wantErr: true,
+		},
+		{
+			name:    "negative increment",
+			tiers:   []IncrementTier{{From: 0, Increment: -500, Percent: 5}},
+			wantErr: true,
+		},
+		{
+			name:    "negative percent",
+			tiers:   []IncrementTier{{From: 0, Increment: 500, Percent: -5}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		rules := &BidValidationRules{Tiers: tt.tiers}
+		err := rules.Validate()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() = %v; want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestGetIncrementRule(t *testing.T) {
+	rules := &BidValidationRules{Tiers: []IncrementTier{
+		{From: 0, To: 10000, Increment: 500},
+		{From: 10000, To: 100000, Increment: 2000},
+		{From: 100000, Percent: 2.5},
+	}}
+
+	tests := []struct {
+		amount Money
+		want   Money
+	}{
+		{amount: 0, want: 500},
+		{amount: 9999, want: 500},
+		// Tier upper bounds are exclusive
+		{amount: 10000, want: 2000},
+		{amount: 99999, want: 2000},
+		{amount: 100000, want: 2500},
+		{amount: 250000, want: 6250},
+	}
+
+	for _, tt := range tests {
+		if got := rules.GetIncrementRule(tt.amount); got != tt.want {
+			t.Errorf("GetIncrementRule(%v) = %v; want %v", tt.amount, got, tt.want)
+		}
+	}
+}
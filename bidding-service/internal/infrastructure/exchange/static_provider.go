@@ -0,0 +1,65 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"auction-system/internal/domain"
+)
+
+// StaticRateProvider serves fixed exchange rates read from a JSON file, for
+// local development and tests:
+//
+//	{"base": "USD", "rates": {"EUR": 0.92, "JPY": 151.2}}
+//
+// Each rate is the price of one unit of the base currency; other pairs are
+// crossed through the base.
+type StaticRateProvider struct {
+	rates map[string]float64
+}
+
+type rateFile struct {
+	Base  string             `json:"base"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+func NewStaticRateProvider(path string) (*StaticRateProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file rateFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse exchange rates %s: %w", path, err)
+	}
+	if file.Base == "" {
+		return nil, fmt.Errorf("exchange rates %s: base currency is required", path)
+	}
+
+	rates := make(map[string]float64, len(file.Rates)+1)
+	for code, rate := range file.Rates {
+		if rate <= 0 {
+			return nil, fmt.Errorf("exchange rates %s: rate for %s must be positive", path, code)
+		}
+		rates[strings.ToUpper(code)] = rate
+	}
+	rates[strings.ToUpper(file.Base)] = 1
+
+	return &StaticRateProvider{rates: rates}, nil
+}
+
+func (p *StaticRateProvider) Rate(ctx context.Context, from, to string) (float64, error) {
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", domain.ErrExchangeRateNotFound, from)
+	}
+	toRate, ok := p.rates[to]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", domain.ErrExchangeRateNotFound, to)
+	}
+	return toRate / fromRate, nil
+}
@@ -3,6 +3,9 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"auction-system/internal/domain"
@@ -10,6 +13,12 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 )
 
+const auctionColumns = `id, start_time, end_time, start_bid, auction_type, reserve_price, buy_now_price,
+        buy_now_until, status, created_at, updated_at, dutch_price_step, dutch_step_interval_seconds,
+        dutch_floor_price, original_end_time, soft_close_window_seconds, soft_close_extension_seconds,
+        soft_close_max_extension_seconds, paused_at, seller_id, title, category, current_price, item_id,
+        sale_id, lot_number, currency`
+
 type MySQLAuctionRepository struct {
 	db *sql.DB
 }
@@ -20,45 +29,135 @@ func NewMySQLAuctionRepository(db *sql.DB) *MySQLAuctionRepository {
 
 func (r *MySQLAuctionRepository) CreateAuction(ctx context.Context, auction *domain.Auction) error {
 	query := `
-        INSERT INTO auctions (id, start_time, end_time, start_bid, status, created_at, updated_at)
-        VALUES (?, ?, ?, ?, ?, ?,?)
+        INSERT INTO auctions (` + auctionColumns + `)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
     `
 	_, err := r.db.ExecContext(ctx, query,
-		auction.ID, auction.StartTime, auction.EndTime, auction.StartBid,
-		int(auction.Status), auction.CreatedAt, auction.UpdatedAt)
+		auction.ID, auction.StartTime, auction.EndTime, auction.StartBid, string(auction.Type), auction.ReservePrice,
+		auction.BuyNowPrice, nullTime(auction.BuyNowUntil),
+		int(auction.Status), auction.CreatedAt, auction.UpdatedAt,
+		auction.DutchPriceStep, int(auction.DutchStepInterval.Seconds()), auction.DutchFloorPrice,
+		auction.OriginalEndTime, int(auction.SoftCloseWindow.Seconds()), int(auction.SoftCloseExtension.Seconds()),
+		int(auction.SoftCloseMaxExtension.Seconds()), nullTime(auction.PausedAt),
+		auction.SellerID, auction.Title, auction.Category, auction.CurrentPrice, nullString(auction.ItemID),
+		nullString(auction.SaleID), auction.LotNumber, auction.Currency)
 	return err
 }
 
 func (r *MySQLAuctionRepository) GetAuction(ctx context.Context, auctionID string) (*domain.Auction, error) {
 	query := `
-        SELECT id, start_time, end_time, start_bid, status, created_at, updated_at
+        SELECT ` + auctionColumns + `
         FROM auctions WHERE id = ?
     `
 
-	var auction domain.Auction
-	var status int
+	auction, err := scanAuction(r.db.QueryRowContext(ctx, query, auctionID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrAuctionNotFound
+	}
+	return auction, err
+}
+
+func (r *MySQLAuctionRepository) UpdateAuctionStatus(ctx context.Context, auctionID string, status domain.AuctionStatus) error {
+	query := `UPDATE auctions SET status = ?, updated_at = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, int(status), time.Now(), auctionID)
+	return err
+}
+
+func (r *MySQLAuctionRepository) TransitionAuctionStatus(ctx context.Context, transition *domain.StatusTransition,
+	event *domain.BidEvent) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE auctions SET status = ?, updated_at = ? WHERE id = ? AND status = ?`,
+		int(transition.To), transition.Timestamp, transition.AuctionID, int(transition.From))
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: auction is no longer %s", domain.ErrInvalidStateTransition, transition.From)
+	}
+
+	query := `
+        INSERT INTO auction_status_history (auction_id, from_status, to_status, actor, reason, created_at)
+        VALUES (?, ?, ?, ?, ?, ?)
+    `
+	result, err = tx.ExecContext(ctx, query,
+		transition.AuctionID, int(transition.From), int(transition.To),
+		transition.Actor, transition.Reason, transition.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	if transition.ID, err = result.LastInsertId(); err != nil {
+		return err
+	}
+
+	if event != nil {
+		if err := insertOutboxEvent(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
 
-	err := r.db.QueryRowContext(ctx, query, auctionID).Scan(
-		&auction.ID, &auction.StartTime, &auction.EndTime, &auction.StartBid,
-		&status, &auction.CreatedAt, &auction.UpdatedAt)
+func (r *MySQLAuctionRepository) GetStatusHistory(ctx context.Context, auctionID string) ([]*domain.StatusTransition, error) {
+	query := `
+        SELECT id, auction_id, from_status, to_status, actor, reason, created_at
+        FROM auction_status_history
+        WHERE auction_id = ?
+        ORDER BY id ASC
+    `
 
+	rows, err := r.db.QueryContext(ctx, query, auctionID)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	auction.Status = domain.AuctionStatus(status)
-	return &auction, nil
+	var history []*domain.StatusTransition
+	for rows.Next() {
+		var transition domain.StatusTransition
+		var from, to int
+
+		err := rows.Scan(&transition.ID, &transition.AuctionID, &from, &to,
+			&transition.Actor, &transition.Reason, &transition.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		transition.From = domain.AuctionStatus(from)
+		transition.To = domain.AuctionStatus(to)
+		history = append(history, &transition)
+	}
+
+	return history, rows.Err()
 }
 
-func (r *MySQLAuctionRepository) UpdateAuctionStatus(ctx context.Context, auctionID string, status domain.AuctionStatus) error {
-	query := `UPDATE auctions SET status = ?, updated_at = ? WHERE id = ?`
-	_, err := r.db.ExecContext(ctx, query, int(status), time.Now(), auctionID)
+func (r *MySQLAuctionRepository) UpdateAuctionEndTime(ctx context.Context, auctionID string, endTime time.Time) error {
+	query := `UPDATE auctions SET end_time = ?, updated_at = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, endTime, time.Now(), auctionID)
+	return err
+}
+
+func (r *MySQLAuctionRepository) UpdateAuctionPausedAt(ctx context.Context, auctionID string, pausedAt time.Time) error {
+	query := `UPDATE auctions SET paused_at = ?, updated_at = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, nullTime(pausedAt), time.Now(), auctionID)
 	return err
 }
 
 func (r *MySQLAuctionRepository) GetActiveAuctions(ctx context.Context) ([]*domain.Auction, error) {
 	query := `
-        SELECT id, start_time, end_time, start_bid, status, created_at, updated_at
+        SELECT ` + auctionColumns + `
         FROM auctions WHERE status = ?
     `
 
@@ -70,18 +169,183 @@ func (r *MySQLAuctionRepository) GetActiveAuctions(ctx context.Context) ([]*doma
 
 	var auctions []*domain.Auction
 	for rows.Next() {
-		var auction domain.Auction
-		var status int
-
-		err := rows.Scan(&auction.ID, &auction.StartTime, &auction.EndTime, &auction.StartBid,
-			&status, &auction.CreatedAt, &auction.UpdatedAt)
+		auction, err := scanAuction(rows)
 		if err != nil {
 			return nil, err
 		}
-
-		auction.Status = domain.AuctionStatus(status)
-		auctions = append(auctions, &auction)
+		auctions = append(auctions, auction)
 	}
 
 	return auctions, nil
 }
+
+func (r *MySQLAuctionRepository) UpdateAuctionPrice(ctx context.Context, auctionID string, price domain.Money) error {
+	query := `UPDATE auctions SET current_price = ?, updated_at = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, price, time.Now(), auctionID)
+	return err
+}
+
+func (r *MySQLAuctionRepository) ListAuctions(ctx context.Context,
+	filter *domain.AuctionFilter) ([]*domain.Auction, *domain.AuctionCursor, error) {
+	var conditions []string
+	var args []interface{}
+
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			placeholders[i] = "?"
+			args = append(args, int(status))
+		}
+		conditions = append(conditions, "status IN ("+strings.Join(placeholders, ", ")+")")
+	}
+
+	addCondition := func(condition string, value interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, value)
+	}
+
+	if !filter.StartsAfter.IsZero() {
+		addCondition("start_time >= ?", filter.StartsAfter)
+	}
+	if !filter.StartsBefore.IsZero() {
+		addCondition("start_time <= ?", filter.StartsBefore)
+	}
+	if !filter.EndsAfter.IsZero() {
+		addCondition("end_time >= ?", filter.EndsAfter)
+	}
+	if !filter.EndsBefore.IsZero() {
+		addCondition("end_time <= ?", filter.EndsBefore)
+	}
+	if filter.Currency != "" {
+		addCondition("currency = ?", filter.Currency)
+	}
+	if filter.MinPrice > 0 {
+		addCondition("current_price >= ?", filter.MinPrice)
+	}
+	if filter.MaxPrice > 0 {
+		addCondition("current_price <= ?", filter.MaxPrice)
+	}
+	if filter.SellerID != "" {
+		addCondition("seller_id = ?", filter.SellerID)
+	}
+	if filter.Category != "" {
+		addCondition("category = ?", filter.Category)
+	}
+	if filter.Query != "" {
+		addCondition("MATCH(title) AGAINST (? IN NATURAL LANGUAGE MODE)", filter.Query)
+	}
+
+	// Keyset pagination: each sort order ends with id so the cursor is unambiguous
+	var orderBy string
+	after := filter.After
+	switch filter.Sort {
+	case domain.SortHighestPrice:
+		orderBy = "current_price DESC, id ASC"
+		if after != nil {
+			conditions = append(conditions, "(current_price < ? OR (current_price = ? AND id > ?))")
+			args = append(args, after.Price, after.Price, after.ID)
+		}
+	case domain.SortNewest:
+		orderBy = "created_at DESC, id ASC"
+		if after != nil {
+			conditions = append(conditions, "(created_at < ? OR (created_at = ? AND id > ?))")
+			args = append(args, after.CreatedAt, after.CreatedAt, after.ID)
+		}
+	default:
+		orderBy = "end_time ASC, id ASC"
+		if after != nil {
+			conditions = append(conditions, "(end_time > ? OR (end_time = ? AND id > ?))")
+			args = append(args, after.EndTime, after.EndTime, after.ID)
+		}
+	}
+
+	query := `SELECT ` + auctionColumns + ` FROM auctions`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY ` + orderBy + ` LIMIT ?`
+
+	// One extra row tells whether there is a next page
+	args = append(args, filter.Limit+1)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var auctions []*domain.Auction
+	for rows.Next() {
+		auction, err := scanAuction(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		auctions = append(auctions, auction)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(auctions) <= filter.Limit {
+		return auctions, nil, nil
+	}
+
+	auctions = auctions[:filter.Limit]
+	return auctions, domain.CursorFor(filter.Sort, auctions[len(auctions)-1]), nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAuction(row rowScanner) (*domain.Auction, error) {
+	var auction domain.Auction
+	var status int
+	var auctionType string
+	var buyNowUntil, originalEndTime, pausedAt sql.NullTime
+	var itemID, saleID sql.NullString
+	var dutchStepSeconds, softCloseWindow, softCloseExtension, softCloseMaxExtension int
+
+	err := row.Scan(&auction.ID, &auction.StartTime, &auction.EndTime, &auction.StartBid,
+		&auctionType, &auction.ReservePrice, &auction.BuyNowPrice, &buyNowUntil,
+		&status, &auction.CreatedAt, &auction.UpdatedAt,
+		&auction.DutchPriceStep, &dutchStepSeconds, &auction.DutchFloorPrice,
+		&originalEndTime, &softCloseWindow, &softCloseExtension, &softCloseMaxExtension,
+		&pausedAt, &auction.SellerID, &auction.Title, &auction.Category, &auction.CurrentPrice, &itemID,
+		&saleID, &auction.LotNumber, &auction.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if pausedAt.Valid {
+		auction.PausedAt = pausedAt.Time
+	}
+	auction.ItemID = itemID.String
+	auction.SaleID = saleID.String
+
+	// Rows created before soft close existed have no original end time
+	auction.OriginalEndTime = auction.EndTime
+	if originalEndTime.Valid {
+		auction.OriginalEndTime = originalEndTime.Time
+	}
+
+	auction.DutchStepInterval = time.Duration(dutchStepSeconds) * time.Second
+	auction.SoftCloseWindow = time.Duration(softCloseWindow) * time.Second
+	auction.SoftCloseExtension = time.Duration(softCloseExtension) * time.Second
+	auction.SoftCloseMaxExtension = time.Duration(softCloseMaxExtension) * time.Second
+	auction.Type = domain.AuctionType(auctionType)
+	auction.Status = domain.AuctionStatus(status)
+	if buyNowUntil.Valid {
+		auction.BuyNowUntil = buyNowUntil.Time
+	}
+	return &auction, nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
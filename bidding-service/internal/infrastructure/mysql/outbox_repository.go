@@ -0,0 +1,69 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"auction-system/internal/domain"
+)
+
+type MySQLOutboxRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLOutboxRepository(db *sql.DB) *MySQLOutboxRepository {
+	return &MySQLOutboxRepository{db: db}
+}
+
+// insertOutboxEvent records the event in tx; its event ID is the row's key
+func insertOutboxEvent(ctx context.Context, tx *sql.Tx, event *domain.BidEvent) error {
+	query := `
+        INSERT INTO event_outbox (id, auction_id, event_type, user_id, amount, bid_id, occurred_at, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err := tx.ExecContext(ctx, query,
+		event.EventID, event.AuctionID, string(event.Type), event.UserID, event.Amount,
+		nullString(event.BidID), event.Timestamp, time.Now())
+	return err
+}
+
+func (r *MySQLOutboxRepository) ListUnsentEvents(ctx context.Context, recordedBefore time.Time, limit int) ([]*domain.BidEvent, error) {
+	query := `
+        SELECT id, auction_id, event_type, user_id, amount, bid_id, occurred_at
+        FROM event_outbox
+        WHERE sent_at IS NULL AND created_at < ?
+        ORDER BY created_at ASC
+        LIMIT ?
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, recordedBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*domain.BidEvent
+	for rows.Next() {
+		var event domain.BidEvent
+		var eventType string
+		var bidID sql.NullString
+
+		err := rows.Scan(&event.EventID, &event.AuctionID, &eventType, &event.UserID,
+			&event.Amount, &bidID, &event.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		event.Type = domain.BidEventType(eventType)
+		event.BidID = bidID.String
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *MySQLOutboxRepository) MarkEventSent(ctx context.Context, eventID string, sentAt time.Time) error {
+	query := `UPDATE event_outbox SET sent_at = ? WHERE id = ? AND sent_at IS NULL`
+	_, err := r.db.ExecContext(ctx, query, sentAt, eventID)
+	return err
+}
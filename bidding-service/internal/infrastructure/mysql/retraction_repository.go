@@ -0,0 +1,118 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"auction-system/internal/domain"
+)
+
+const retractionColumns = `id, auction_id, user_id, bid_id, amount, reason, status,
+        requested_at, decided_by, decision_note, decided_at`
+
+type MySQLRetractionRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLRetractionRepository(db *sql.DB) *MySQLRetractionRepository {
+	return &MySQLRetractionRepository{db: db}
+}
+
+func (r *MySQLRetractionRepository) CreateRetraction(ctx context.Context, retraction *domain.BidRetraction) error {
+	query := `
+        INSERT INTO bid_retractions (` + retractionColumns + `)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err := r.db.ExecContext(ctx, query,
+		retraction.ID, retraction.AuctionID, retraction.UserID, nullString(retraction.BidID),
+		retraction.Amount, retraction.Reason, string(retraction.Status), retraction.RequestedAt,
+		retraction.DecidedBy, retraction.DecisionNote, nullTime(retraction.DecidedAt))
+	return err
+}
+
+func (r *MySQLRetractionRepository) GetRetraction(ctx context.Context, retractionID string) (*domain.BidRetraction, error) {
+	query := `SELECT ` + retractionColumns + ` FROM bid_retractions WHERE id = ?`
+
+	retraction, err := scanRetraction(r.db.QueryRowContext(ctx, query, retractionID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrRetractionNotFound
+	}
+	return retraction, err
+}
+
+func (r *MySQLRetractionRepository) ListRetractions(ctx context.Context, auctionID string,
+	status domain.RetractionStatus) ([]*domain.BidRetraction, error) {
+	query := `SELECT ` + retractionColumns + ` FROM bid_retractions WHERE 1 = 1`
+	var args []interface{}
+
+	if auctionID != "" {
+		query += ` AND auction_id = ?`
+		args = append(args, auctionID)
+	}
+	if status != "" {
+		query += ` AND status = ?`
+		args = append(args, string(status))
+	}
+	query += ` ORDER BY requested_at ASC, id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var retractions []*domain.BidRetraction
+	for rows.Next() {
+		retraction, err := scanRetraction(rows)
+		if err != nil {
+			return nil, err
+		}
+		retractions = append(retractions, retraction)
+	}
+
+	return retractions, rows.Err()
+}
+
+func (r *MySQLRetractionRepository) DecideRetraction(ctx context.Context, retraction *domain.BidRetraction,
+	from domain.RetractionStatus) error {
+	query := `
+        UPDATE bid_retractions SET status = ?, decided_by = ?, decision_note = ?, decided_at = ?
+        WHERE id = ? AND status = ?
+    `
+	result, err := r.db.ExecContext(ctx, query,
+		string(retraction.Status), retraction.DecidedBy, retraction.DecisionNote, nullTime(retraction.DecidedAt),
+		retraction.ID, string(from))
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: retraction is no longer %s", domain.ErrRetractionDecided, from)
+	}
+	return nil
+}
+
+func scanRetraction(row rowScanner) (*domain.BidRetraction, error) {
+	var retraction domain.BidRetraction
+	var bidID sql.NullString
+	var status string
+	var decidedAt sql.NullTime
+
+	err := row.Scan(&retraction.ID, &retraction.AuctionID, &retraction.UserID, &bidID, &retraction.Amount,
+		&retraction.Reason, &status, &retraction.RequestedAt, &retraction.DecidedBy,
+		&retraction.DecisionNote, &decidedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	retraction.BidID = bidID.String
+	retraction.Status = domain.RetractionStatus(status)
+	retraction.DecidedAt = decidedAt.Time
+	return &retraction, nil
+}
@@ -0,0 +1,191 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"auction-system/internal/domain"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	deadLetterStream = "auction_events:dead_letters"
+	deadLetterMaxLen = 10000
+	// Followed by the consumer; a list of payloads its subscriber takes back
+	replayQueuePrefix = "auction_events:replays:"
+)
+
+var streamIDPattern = regexp.MustCompile(`^\d+-\d+$`)
+
+// DeadLetterStoreImpl keeps dead letters in deadLetterStream, all services'
+// together, capped at about deadLetterMaxLen entries. A dead letter's ID is its
+// stream entry ID.
+type DeadLetterStoreImpl struct {
+	client *redis.Client
+}
+
+func NewDeadLetterStore(client *redis.Client) *DeadLetterStoreImpl {
+	return &DeadLetterStoreImpl{client: client}
+}
+
+func (r *DeadLetterStoreImpl) AddDeadLetter(ctx context.Context, letter *domain.DeadLetter) error {
+	id, err := r.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: deadLetterStream,
+		MaxLen: deadLetterMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"consumer":   letter.Consumer,
+			"payload":    letter.Payload,
+			"event_id":   letter.EventID,
+			"event_type": string(letter.Type),
+			"auction_id": letter.AuctionID,
+			"error":      letter.Error,
+			"attempts":   letter.Attempts,
+			"failed_at":  letter.FailedAt.UnixMilli(),
+		},
+	}).Result()
+	if err != nil {
+		return err
+	}
+
+	letter.ID = id
+	return nil
+}
+
+func (r *DeadLetterStoreImpl) ListDeadLetters(ctx context.Context, consumer string, limit int) ([]*domain.DeadLetter, error) {
+	letters := make([]*domain.DeadLetter, 0)
+	end := "+"
+	for len(letters) < limit {
+		messages, err := r.client.XRevRangeN(ctx, deadLetterStream, end, "-", int64(limit)).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, msg := range messages {
+			letter := newDeadLetter(msg)
+			if consumer == "" || letter.Consumer == consumer {
+				letters = append(letters, letter)
+				if len(letters) == limit {
+					break
+				}
+			}
+		}
+
+		if len(messages) < limit {
+			break
+		}
+		end = "(" + messages[len(messages)-1].ID
+	}
+
+	return letters, nil
+}
+
+func (r *DeadLetterStoreImpl) GetDeadLetter(ctx context.Context, id string) (*domain.DeadLetter, error) {
+	if !streamIDPattern.MatchString(id) {
+		return nil, domain.ErrDeadLetterNotFound
+	}
+
+	messages, err := r.client.XRange(ctx, deadLetterStream, id, id).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, domain.ErrDeadLetterNotFound
+	}
+
+	return newDeadLetter(messages[0]), nil
+}
+
+func (r *DeadLetterStoreImpl) ReplayDeadLetter(ctx context.Context, id string) (*domain.DeadLetter, error) {
+	if !streamIDPattern.MatchString(id) {
+		return nil, domain.ErrDeadLetterNotFound
+	}
+
+	// Atomic, so two admins replaying the same dead letter do not queue it twice
+	luaScript := `
+        local entries = redis.call('XRANGE', KEYS[1], ARGV[1], ARGV[1])
+        if #entries == 0 then
+            return false
+        end
+
+        local fields = entries[1][2]
+        local values = {}
+        for i = 1, #fields, 2 do
+            values[fields[i]] = fields[i + 1]
+        end
+
+        redis.call('RPUSH', ARGV[2] .. values['consumer'], values['payload'])
+        redis.call('XDEL', KEYS[1], ARGV[1])
+        return fields
+    `
+
+	result, err := r.client.Eval(ctx, luaScript, []string{deadLetterStream}, id, replayQueuePrefix).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, domain.ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fields, ok := result.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected replay result: %v", result)
+	}
+	values := make(map[string]interface{}, len(fields)/2)
+	for i := 0; i+1 < len(fields); i += 2 {
+		key, _ := fields[i].(string)
+		values[key] = fields[i+1]
+	}
+
+	return newDeadLetter(redis.XMessage{ID: id, Values: values}), nil
+}
+
+func (r *DeadLetterStoreImpl) DiscardDeadLetter(ctx context.Context, id string) error {
+	if !streamIDPattern.MatchString(id) {
+		return domain.ErrDeadLetterNotFound
+	}
+
+	deleted, err := r.client.XDel(ctx, deadLetterStream, id).Result()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domain.ErrDeadLetterNotFound
+	}
+	return nil
+}
+
+func (r *DeadLetterStoreImpl) TakeReplays(ctx context.Context, consumer string, limit int) ([]string, error) {
+	payloads, err := r.client.LPopCount(ctx, replayQueuePrefix+consumer, limit).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return payloads, err
+}
+
+func newDeadLetter(msg redis.XMessage) *domain.DeadLetter {
+	field := func(name string) string {
+		value, _ := msg.Values[name].(string)
+		return value
+	}
+
+	attempts, _ := strconv.Atoi(field("attempts"))
+	failedAt, _ := strconv.ParseInt(field("failed_at"), 10, 64)
+
+	return &domain.DeadLetter{
+		ID:        msg.ID,
+		Consumer:  field("consumer"),
+		Payload:   field("payload"),
+		EventID:   field("event_id"),
+		Type:      domain.BidEventType(field("event_type")),
+		AuctionID: field("auction_id"),
+		Error:     field("error"),
+		Attempts:  attempts,
+		FailedAt:  time.UnixMilli(failedAt),
+	}
+}
@@ -0,0 +1,117 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/pkg/utils"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// deniedEventWindow is how often at most a bidder's denials on one auction are
+// published, so a client flooding bids does not flood the event consumers too
+const deniedEventWindow = time.Minute
+
+type BidRateLimiterImpl struct {
+	client     *redis.Client
+	perUser    domain.RateLimit
+	perAuction domain.RateLimit
+	events     EventSink
+}
+
+// NewBidRateLimiter limits each user by perUser across all auctions and by
+// perAuction within a single auction. The first denial of a user on an auction
+// in each deniedEventWindow is published to events.
+func NewBidRateLimiter(client *redis.Client, perUser, perAuction domain.RateLimit, events EventSink) *BidRateLimiterImpl {
+	return &BidRateLimiterImpl{client: client, perUser: perUser, perAuction: perAuction, events: events}
+}
+
+func (r *BidRateLimiterImpl) AllowBid(ctx context.Context, bid *domain.BidRequest) (bool, error) {
+	if !r.perUser.Enabled() && !r.perAuction.Enabled() {
+		return true, nil
+	}
+
+	// Buckets are hashes of the tokens left and the last refill in milliseconds.
+	// Both buckets are checked before either is charged, so a bid denied by one
+	// does not use up the other.
+	luaScript := `
+` + eventLua + `
+        local now = tonumber(ARGV[1])
+        local buckets = {
+            {key = KEYS[1], rate = tonumber(ARGV[2]), burst = tonumber(ARGV[3]), ttl = ARGV[4]},
+            {key = KEYS[2], rate = tonumber(ARGV[5]), burst = tonumber(ARGV[6]), ttl = ARGV[7]},
+        }
+
+        local allowed = true
+        for _, bucket in ipairs(buckets) do
+            if bucket.burst > 0 then
+                local state = redis.call('HMGET', bucket.key, 'tokens', 'refilled_at')
+                local tokens = tonumber(state[1] or bucket.burst)
+                local elapsed = math.max(0, now - tonumber(state[2] or now))
+                bucket.tokens = math.min(bucket.burst, tokens + elapsed * bucket.rate / 1000)
+                if bucket.tokens < 1 then
+                    allowed = false
+                end
+            end
+        end
+
+        for _, bucket in ipairs(buckets) do
+            if bucket.burst > 0 then
+                if allowed then
+                    bucket.tokens = bucket.tokens - 1
+                end
+                redis.call('HSET', bucket.key, 'tokens', tostring(bucket.tokens), 'refilled_at', ARGV[1])
+                -- A bucket left alone until it is full again is the same as no bucket
+                redis.call('PEXPIRE', bucket.key, bucket.ttl)
+            end
+        end
+
+        if allowed then
+            return 1
+        end
+
+        if redis.call('SET', KEYS[3], '1', 'NX', 'PX', ARGV[12]) then
+            publish_event(ARGV[8], "rate_limited", ARGV[9], tonumber(ARGV[10]), math.floor(now / 1000), ARGV[11])
+        end
+        return 0
+    `
+
+	userKey := fmt.Sprintf("rate_limit:bids:user:%s", bid.UserID)
+	auctionKey := fmt.Sprintf("rate_limit:bids:auction:%s:user:%s", bid.AuctionID, bid.UserID)
+	deniedKey := fmt.Sprintf("rate_limit:bids:denied:%s:user:%s", bid.AuctionID, bid.UserID)
+
+	result, err := r.client.Eval(ctx, luaScript, []string{userKey, auctionKey, deniedKey}, eventArgs(r.events, utils.GenerateID("event"),
+		strconv.FormatInt(time.Now().UnixMilli(), 10),
+		r.perUser.Rate, bucketSize(r.perUser), bucketTTL(r.perUser),
+		r.perAuction.Rate, bucketSize(r.perAuction), bucketTTL(r.perAuction),
+		bid.AuctionID,
+		bid.UserID,
+		cents(bid.Amount),
+		bid.BidID,
+		deniedEventWindow.Milliseconds())...).Int64()
+	if err != nil {
+		return false, err
+	}
+
+	return result == 1, nil
+}
+
+// bucketSize is the burst of an enabled limit and 0, which the script skips, otherwise
+func bucketSize(limit domain.RateLimit) int {
+	if !limit.Enabled() {
+		return 0
+	}
+	return limit.Burst
+}
+
+// bucketTTL is how long, in milliseconds, an idle bucket is kept
+func bucketTTL(limit domain.RateLimit) int64 {
+	if !limit.Enabled() {
+		return 0
+	}
+	return limit.RefillTime().Milliseconds() + 1
+}
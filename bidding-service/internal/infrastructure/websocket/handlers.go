@@ -5,6 +5,7 @@ import (
 	"context"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
 	"auction-system/internal/domain"
@@ -24,17 +25,22 @@ var upgrader = websocket.Upgrader{
 type WebSocketHandler struct {
 	bidService  *services.BidService
 	auctionRepo repositories.AuctionRepository
+	itemRepo    repositories.ItemRepository
+	saleRepo    repositories.SaleRepository
 	connManager domain.ConnectionManager
 	log         logger.Logger
 }
 
 func NewWebSocketHandler(bidService *services.BidService,
-	auctionRepo repositories.AuctionRepository,
-	connManager domain.ConnectionManager, log logger.Logger) *WebSocketHandler {
+	auctionRepo repositories.AuctionRepository, itemRepo repositories.ItemRepository,
+	saleRepo repositories.SaleRepository, connManager domain.ConnectionManager,
+	log logger.Logger) *WebSocketHandler {
 	return &WebSocketHandler{
 		bidService:  bidService,
 		connManager: connManager,
 		auctionRepo: auctionRepo,
+		itemRepo:    itemRepo,
+		saleRepo:    saleRepo,
 		log:         log,
 	}
 }
@@ -55,6 +61,12 @@ func (h *WebSocketHandler) HandleConnection(w http.ResponseWriter, r *http.Reque
 	// Check auction status
 	now := time.Now()
 
+	if auction.Status == domain.AuctionCancelled {
+		h.log.Info("Rejected connection - auction was cancelled", "auctionID", auctionID)
+		http.Error(w, "auction was cancelled", http.StatusForbidden)
+		return
+	}
+
 	if now.After(auction.EndTime) {
 		h.log.Info("Rejected connection - auction has ended", "auctionID", auctionID)
 		http.Error(w, "auction has already ended", http.StatusForbidden)
@@ -91,10 +103,47 @@ func (h *WebSocketHandler) HandleConnection(w http.ResponseWriter, r *http.Reque
 		h.log.Error("Failed to initialize auction cache", "error", err)
 	}
 
+	h.sendWelcome(r.Context(), wsConn, auction)
+
 	// Start message handling
 	go h.handleMessages(wsConn, userID, auctionID)
 }
 
+// sendWelcome tells a newly connected bidder what is being sold and where the bidding stands
+func (h *WebSocketHandler) sendWelcome(ctx context.Context, conn *WebSocketConnection, auction *domain.Auction) {
+	welcome := map[string]interface{}{
+		"type":         "welcome",
+		"auction_id":   auction.ID,
+		"auction_type": auction.Type,
+		"status":       auction.Status.String(),
+		"title":        auction.Title,
+		"currency":     auction.Currency,
+		"end_time":     auction.EndTime,
+	}
+
+	if state, err := h.bidService.GetAuctionState(ctx, auction.ID); err != nil {
+		h.log.Error("Failed to load auction state for welcome", "auction_id", auction.ID, "error", err)
+	} else {
+		welcome["current_bid"] = state.CurrentBid
+		welcome["sequence"] = state.Sequence
+		if !state.EndTime.IsZero() {
+			welcome["end_time"] = state.EndTime
+		}
+	}
+
+	if auction.ItemID != "" {
+		if item, err := h.itemRepo.GetItem(ctx, auction.ItemID); err != nil {
+			h.log.Error("Failed to load auction item for welcome", "auction_id", auction.ID, "error", err)
+		} else {
+			welcome["item"] = item.Summary()
+		}
+	}
+
+	if err := conn.Send(welcome); err != nil {
+		h.log.Error("Failed to send welcome message", "auction_id", auction.ID, "error", err)
+	}
+}
+
 func (h *WebSocketHandler) handleMessages(conn *WebSocketConnection, userID, auctionID string) {
 	defer func() {
 		h.connManager.UnregisterConnection(userID, auctionID)
@@ -122,36 +171,285 @@ func (h *WebSocketHandler) handleMessages(conn *WebSocketConnection, userID, auc
 		switch msgType {
 		case "place_bid":
 			h.handleBidMessage(conn, userID, auctionID, msg)
+		case "accept":
+			h.handleAcceptMessage(conn, userID, auctionID, msg)
 		case "ping":
 			conn.Send(map[string]string{"type": "pong"})
 		}
 	}
 }
 
+// HandleSaleConnection subscribes one connection to every lot of a sale. The
+// connection is registered under the sale ID, which the event listener
+// broadcasts lot updates to; bids name the lot in auction_id.
+func (h *WebSocketHandler) HandleSaleConnection(w http.ResponseWriter, r *http.Request) {
+	saleID := mux.Vars(r)["saleID"]
+
+	sale, err := h.saleRepo.GetSale(r.Context(), saleID)
+	if err != nil {
+		h.log.Error("Failed to find sale", "error", err, "saleID", saleID)
+		http.Error(w, "sale not found", http.StatusNotFound)
+		return
+	}
+
+	if sale.Status == domain.SaleCancelled {
+		http.Error(w, "sale was cancelled", http.StatusForbidden)
+		return
+	}
+
+	lots, err := h.saleRepo.GetSaleLots(r.Context(), saleID)
+	if err != nil {
+		h.log.Error("Failed to load sale lots", "error", err, "saleID", saleID)
+		http.Error(w, "failed to load sale", http.StatusInternalServerError)
+		return
+	}
+
+	userID := r.URL.Query().Get("user_id")
+	if userID == "" {
+		http.Error(w, "user_id required", http.StatusBadRequest)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.log.Error("Failed to upgrade connection", "error", err)
+		return
+	}
+
+	wsConn := NewWebSocketConnection(conn, userID, saleID, h.log)
+	if err := h.connManager.RegisterConnection(userID, saleID, wsConn); err != nil {
+		h.log.Error("Failed to register connection", "error", err)
+		conn.Close()
+		return
+	}
+
+	h.sendSaleWelcome(r.Context(), wsConn, sale, lots)
+
+	lotIDs := make(map[string]bool, len(lots))
+	for _, lot := range lots {
+		lotIDs[lot.ID] = true
+	}
+
+	go h.handleSaleMessages(wsConn, userID, saleID, lotIDs)
+}
+
+func (h *WebSocketHandler) sendSaleWelcome(ctx context.Context, conn *WebSocketConnection,
+	sale *domain.Sale, lots []*domain.Auction) {
+	var itemIDs []string
+	for _, lot := range lots {
+		if lot.ItemID != "" {
+			itemIDs = append(itemIDs, lot.ItemID)
+		}
+	}
+
+	items, err := h.itemRepo.GetItems(ctx, itemIDs)
+	if err != nil {
+		h.log.Error("Failed to load sale items for welcome", "sale_id", sale.ID, "error", err)
+	}
+
+	lotMessages := make([]map[string]interface{}, 0, len(lots))
+	for _, lot := range lots {
+		lotMessage := map[string]interface{}{
+			"auction_id":   lot.ID,
+			"lot_number":   lot.LotNumber,
+			"auction_type": lot.Type,
+			"status":       lot.Status.String(),
+			"title":        lot.Title,
+			"end_time":     lot.EndTime,
+			"current_bid":  lot.CurrentPrice,
+			"currency":     lot.Currency,
+		}
+		if state, err := h.bidService.GetAuctionState(ctx, lot.ID); err == nil {
+			lotMessage["current_bid"] = state.CurrentBid
+			lotMessage["sequence"] = state.Sequence
+			if !state.EndTime.IsZero() {
+				lotMessage["end_time"] = state.EndTime
+			}
+		}
+		if item, ok := items[lot.ItemID]; ok {
+			lotMessage["item"] = item.Summary()
+		}
+		lotMessages = append(lotMessages, lotMessage)
+	}
+
+	welcome := map[string]interface{}{
+		"type":    "welcome",
+		"sale_id": sale.ID,
+		"title":   sale.Title,
+		"status":  string(sale.Status),
+		"lots":    lotMessages,
+	}
+	if err := conn.Send(welcome); err != nil {
+		h.log.Error("Failed to send welcome message", "sale_id", sale.ID, "error", err)
+	}
+}
+
+func (h *WebSocketHandler) handleSaleMessages(conn *WebSocketConnection, userID, saleID string,
+	lotIDs map[string]bool) {
+	defer func() {
+		h.connManager.UnregisterConnection(userID, saleID)
+		conn.Close()
+	}()
+
+	for {
+		var msg map[string]interface{}
+		if err := conn.conn.ReadJSON(&msg); err != nil {
+			h.log.Error("Failed to read message", "error", err)
+			return
+		}
+
+		msgType, ok := msg["type"].(string)
+		if !ok {
+			continue
+		}
+
+		if msgType == "ping" {
+			conn.Send(map[string]string{"type": "pong"})
+			continue
+		}
+
+		auctionID, _ := msg["auction_id"].(string)
+		if !lotIDs[auctionID] {
+			conn.Send(map[string]string{"type": "error", "message": "auction_id must be a lot of this sale"})
+			continue
+		}
+
+		switch msgType {
+		case "place_bid":
+			h.handleBidMessage(conn, userID, auctionID, msg)
+		case "accept":
+			h.handleAcceptMessage(conn, userID, auctionID, msg)
+		}
+	}
+}
+
+// handleBidMessage answers every place_bid with a bid_ack or bid_rejected reply,
+// correlated by the bid_id and amount the client sent
 func (h *WebSocketHandler) handleBidMessage(conn *WebSocketConnection, userID, auctionID string, msg map[string]interface{}) {
-	amountStr, ok := msg["amount"].(string)
-	if !ok {
-		conn.Send(map[string]string{"type": "error", "message": "invalid amount"})
+	bidID, _ := msg["bid_id"].(string)
+	amountStr, _ := moneyField(msg, "amount")
+
+	// A client that is unsure whether a bid went through (e.g. after reconnecting)
+	// resends it with the same bid_id and gets the original outcome back
+	if bidID != "" && !domain.ValidBidID(bidID) {
+		sendBidRejected(conn, auctionID, bidID, amountStr, "invalid_bid_id")
 		return
 	}
 
-	amount, err := strconv.ParseFloat(amountStr, 64)
+	amount, err := domain.ParseMoney(amountStr)
 	if err != nil {
-		conn.Send(map[string]string{"type": "error", "message": "invalid amount format"})
+		sendBidRejected(conn, auctionID, bidID, amountStr, "invalid_amount")
 		return
 	}
 
-	if err := h.bidService.PlaceBid(context.Background(), auctionID, userID, amount); err != nil {
+	// max_amount is optional and turns the bid into a proxy bid
+	var maxAmount domain.Money
+	maxAmountStr, ok := moneyField(msg, "max_amount")
+	if !ok {
+		sendBidRejected(conn, auctionID, bidID, amountStr, "invalid_max_amount")
+		return
+	}
+	if maxAmountStr != "" {
+		maxAmount, err = domain.ParseMoney(maxAmountStr)
+		if err != nil || maxAmount < amount {
+			sendBidRejected(conn, auctionID, bidID, amountStr, "invalid_max_amount")
+			return
+		}
+	}
+
+	// currency is optional; a bid naming another currency than the auction's is rejected
+	currency, _ := msg["currency"].(string)
+
+	outcome, err := h.bidService.PlaceBid(context.Background(), &domain.BidRequest{
+		AuctionID: auctionID,
+		UserID:    userID,
+		BidID:     bidID,
+		Amount:    amount,
+		MaxAmount: maxAmount,
+		Currency:  currency,
+	})
+	if err != nil {
 		h.log.Error("Failed to place bid", "error", err)
-		conn.Send(map[string]string{"type": "error", "message": "failed to place bid"})
+		sendBidRejected(conn, auctionID, bidID, amountStr, "internal_error")
+		return
+	}
+	sendBidReply(conn, auctionID, bidID, amountStr, outcome)
+}
+
+// moneyField reads an amount sent either as a decimal string or as a JSON
+// number. It returns "" for a missing field and false for one of another type.
+func moneyField(msg map[string]interface{}, key string) (string, bool) {
+	switch value := msg[key].(type) {
+	case nil:
+		return "", true
+	case string:
+		return value, true
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+func (h *WebSocketHandler) handleAcceptMessage(conn *WebSocketConnection, userID, auctionID string, msg map[string]interface{}) {
+	bidID, _ := msg["bid_id"].(string)
+	if bidID != "" && !domain.ValidBidID(bidID) {
+		sendBidRejected(conn, auctionID, bidID, "", "invalid_bid_id")
+		return
+	}
+
+	outcome, err := h.bidService.AcceptPrice(context.Background(), auctionID, userID, bidID)
+	if err != nil {
+		h.log.Error("Failed to accept price", "error", err)
+		sendBidRejected(conn, auctionID, bidID, "", "internal_error")
+		return
+	}
+	sendBidReply(conn, auctionID, bidID, "", outcome)
+}
+
+// sendBidReply sends bid_ack or bid_rejected with the outcome's reason code and
+// the auction as the bidder sees it now
+func sendBidReply(conn *WebSocketConnection, auctionID, bidID, amount string, outcome *domain.BidOutcome) {
+	reply := bidReply(auctionID, bidID, amount, outcome.Reason)
+	if outcome.Accepted {
+		reply["type"] = "bid_ack"
+	}
+	reply["current_price"] = outcome.CurrentPrice
+	reply["leading"] = outcome.Leading
+	reply["next_minimum_bid"] = outcome.NextMinimumBid
+	reply["replayed"] = outcome.Replayed
+	conn.Send(reply)
+}
+
+// sendBidRejected rejects a bid that never reached the bid cache
+func sendBidRejected(conn *WebSocketConnection, auctionID, bidID, amount, reason string) {
+	conn.Send(bidReply(auctionID, bidID, amount, reason))
+}
+
+func bidReply(auctionID, bidID, amount, reason string) map[string]interface{} {
+	reply := map[string]interface{}{
+		"type":       "bid_rejected",
+		"auction_id": auctionID,
+		"reason":     reason,
+	}
+	if bidID != "" {
+		reply["bid_id"] = bidID
+	}
+	if amount != "" {
+		reply["amount"] = amount
 	}
+	return reply
 }
 
+// WebSocketConnection is written to by its own reader, with bid replies, and by
+// the event listener's broadcasts. gorilla/websocket allows one writer at a
+// time, so writes hold writeMutex.
 type WebSocketConnection struct {
-	conn      *websocket.Conn
-	userID    string
-	auctionID string
-	log       logger.Logger
+	conn       *websocket.Conn
+	writeMutex sync.Mutex
+	userID     string
+	auctionID  string
+	log        logger.Logger
 }
 
 func NewWebSocketConnection(conn *websocket.Conn, userID, auctionID string, log logger.Logger) *WebSocketConnection {
@@ -164,6 +462,8 @@ func NewWebSocketConnection(conn *websocket.Conn, userID, auctionID string, log
 }
 
 func (wsc *WebSocketConnection) Send(message interface{}) error {
+	wsc.writeMutex.Lock()
+	defer wsc.writeMutex.Unlock()
 	return wsc.conn.WriteJSON(message)
 }
 
@@ -11,75 +11,135 @@ import (
 )
 
 type BidService struct {
-	bidCache     domain.BidCache
-	stateCache   domain.AuctionStateCache
-	userNotifier domain.UserNotifier
-	localCache   map[string]*domain.LocalAuctionCache
-	cacheMutex   sync.RWMutex
-	log          logger.Logger
+	bidCache    domain.BidCache
+	stateCache  domain.AuctionStateCache
+	rateLimiter domain.BidRateLimiter
+	localCache  map[string]*domain.LocalAuctionCache
+	cacheMutex  sync.RWMutex
+	log         logger.Logger
 }
 
 func NewBidService(
 	bidCache domain.BidCache,
 	stateCache domain.AuctionStateCache,
-	userNotifier domain.UserNotifier,
+	rateLimiter domain.BidRateLimiter,
 	log logger.Logger,
 ) *BidService {
 	service := &BidService{
-		bidCache:     bidCache,
-		stateCache:   stateCache,
-		userNotifier: userNotifier,
-		localCache:   make(map[string]*domain.LocalAuctionCache),
-		log:          log,
+		bidCache:    bidCache,
+		stateCache:  stateCache,
+		rateLimiter: rateLimiter,
+		localCache:  make(map[string]*domain.LocalAuctionCache),
+		log:         log,
 	}
 
 	return service
 }
 
-func (s *BidService) PlaceBid(ctx context.Context, auctionID, userID string, amount float64) error {
-	s.log.Info("Placing bid", "auction_id", auctionID, "user_id", userID, "amount", amount)
+// PlaceBid submits a bid for the user. A MaxAmount above Amount registers a proxy
+// ceiling up to which the system keeps bidding on the user's behalf. The currency
+// must be the auction's own; an empty currency bids in it implicitly. A bid ID
+// that was used before returns the original outcome without bidding again.
+// Other bids over the user's rate limit are rejected as rate_limited before
+// anything else and are not remembered under their bid ID, so they can be
+// resent later.
+//
+// Rejections are outcomes, not errors; an error means the bid could not be processed.
+func (s *BidService) PlaceBid(ctx context.Context, bid *domain.BidRequest) (*domain.BidOutcome, error) {
+	s.log.Info("Placing bid", "auction_id", bid.AuctionID, "user_id", bid.UserID, "bid_id", bid.BidID,
+		"amount", bid.Amount)
+
+	// A resend arriving after the auction moved on still gets its original outcome,
+	// and does not count against the rate limit
+	if bid.BidID != "" {
+		outcome, err := s.bidCache.GetBidOutcome(ctx, bid.AuctionID, bid.UserID, bid.BidID)
+		if err != nil {
+			return nil, err
+		}
+		if outcome != nil {
+			return s.withLiveState(ctx, bid, outcome)
+		}
+	}
+
+	allowed, err := s.rateLimiter.AllowBid(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		s.log.Warn("Bid rate limited", "auction_id", bid.AuctionID, "user_id", bid.UserID, "bid_id", bid.BidID)
+		return s.withCachedState(ctx, bid, &domain.BidOutcome{Reason: "rate_limited"})
+	}
 
 	// Check auction status first
-	status, err := s.stateCache.GetAuctionStatus(ctx, auctionID)
+	status, err := s.stateCache.GetAuctionStatus(ctx, bid.AuctionID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if status != domain.AuctionActive {
-		err := s.userNotifier.NotifyUser(ctx, userID, map[string]interface{}{
-			"type":   "bid_rejected",
-			"reason": "auction_not_active",
-			"status": status.String(),
-		})
-		if err != nil {
-			s.log.Error("Failed to notify user", "auction_id", auctionID, "user_id", userID)
-			return err
-		}
-		return nil
+		return s.withLiveState(ctx, bid, &domain.BidOutcome{Reason: "auction_not_active"})
 	}
 
 	// Initialize auction cache if not exists
-	if err := s.ensureAuctionCached(ctx, auctionID); err != nil {
-		return err
+	if err := s.ensureAuctionCached(ctx, bid.AuctionID); err != nil {
+		return nil, err
 	}
 
 	// Atomic Redis update
-	_, err = s.bidCache.AtomicBidUpdate(ctx, auctionID, userID, amount)
+	outcome, err := s.bidCache.AtomicBidUpdate(ctx, bid)
 	if err != nil {
-		s.log.Error("Failed to update bid", "error", err)
-		err := s.userNotifier.NotifyUser(ctx, userID, map[string]interface{}{
-			"type":           "bid_rejected",
-			"reason":         err.Error(),
-			"current_bid":    amount,
-			"current_winner": userID,
-		})
-		if err != nil {
-			return err
-		}
-		return err
+		s.log.Error("Failed to update bid", "auction_id", bid.AuctionID, "user_id", bid.UserID, "error", err)
+		return nil, err
 	}
 
-	return nil
+	return outcome, nil
+}
+
+// withLiveState adds the auction's current state to an outcome that did not come
+// straight from AtomicBidUpdate, which reports it itself
+func (s *BidService) withLiveState(ctx context.Context, bid *domain.BidRequest,
+	outcome *domain.BidOutcome) (*domain.BidOutcome, error) {
+	state, err := s.bidCache.GetCurrentBid(ctx, bid.AuctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome.CurrentPrice = state.CurrentBid
+	outcome.Leading = state.IsLeading(bid.UserID)
+	outcome.NextMinimumBid = state.NextMinimumBid()
+	return outcome, nil
+}
+
+// withCachedState is withLiveState from the local cache, for rejections that
+// should not cost another trip to Redis
+func (s *BidService) withCachedState(ctx context.Context, bid *domain.BidRequest,
+	outcome *domain.BidOutcome) (*domain.BidOutcome, error) {
+	state, err := s.GetAuctionState(ctx, bid.AuctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome.CurrentPrice = state.CurrentBid
+	outcome.Leading = state.IsLeading(bid.UserID)
+	outcome.NextMinimumBid = state.NextMinimumBid()
+	return outcome, nil
+}
+
+// AcceptPrice accepts the current price of a Dutch auction. The bid cache settles
+// the race between bidders, so only the first accept wins.
+func (s *BidService) AcceptPrice(ctx context.Context, auctionID, userID, bidID string) (*domain.BidOutcome, error) {
+	current, err := s.bidCache.GetCurrentBid(ctx, auctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A drop landing after this read only lowers the price the bidder pays
+	return s.PlaceBid(ctx, &domain.BidRequest{
+		AuctionID: auctionID,
+		UserID:    userID,
+		BidID:     bidID,
+		Amount:    current.CurrentBid,
+	})
 }
 
 func (s *BidService) ensureAuctionCached(ctx context.Context, auctionID string) error {
@@ -101,17 +161,111 @@ func (s *BidService) ensureAuctionCached(ctx context.Context, auctionID string)
 	return nil
 }
 
-func (s *BidService) UpdateLocalCache(auctionID string, bid float64, winnerID string) {
+// RefreshAuctionCache reloads the auction's cached state from the bid cache
+func (s *BidService) RefreshAuctionCache(ctx context.Context, auctionID string) (*domain.LocalAuctionCache, error) {
+	auctionCache, err := s.bidCache.GetCurrentBid(ctx, auctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cacheMutex.Lock()
+	s.localCache[auctionID] = auctionCache
+	s.cacheMutex.Unlock()
+
+	return auctionCache, nil
+}
+
+// SetCachedEndTime records a new end time for the auction if it is cached; an
+// auction loaded later reads it from the bid cache
+func (s *BidService) SetCachedEndTime(auctionID string, endTime time.Time) {
 	s.cacheMutex.Lock()
 	defer s.cacheMutex.Unlock()
 
+	if cached, exists := s.localCache[auctionID]; exists {
+		cached.EndTime = endTime
+	}
+}
+
+// UpdateLocalCache applies the price and leader of a bid event. An event older
+// than the cached state, going by their sequence numbers, is ignored.
+func (s *BidService) UpdateLocalCache(event *domain.BidEvent) {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+
+	auctionID := event.AuctionID
 	//TODO fix this using logger
-	fmt.Printf("Updating local cache for auction %s: bid=%.2f, winner=%s\n", auctionID, bid, winnerID)
-	s.localCache[auctionID] = &domain.LocalAuctionCache{
-		CurrentBid:  bid,
-		WinnerID:    winnerID,
+	fmt.Printf("Updating local cache for auction %s: bid=%s, winner=%s\n", auctionID, event.Amount, event.UserID)
+	updated := &domain.LocalAuctionCache{
+		AuctionID:   auctionID,
+		CurrentBid:  event.Amount,
+		WinnerID:    event.UserID,
+		Sequence:    event.Sequence,
 		LastUpdated: time.Now(),
 	}
+	// Keep the per-auction settings loaded from Redis
+	if existing, exists := s.localCache[auctionID]; exists {
+		if event.Sequence != 0 && event.Sequence < existing.Sequence {
+			return
+		}
+		updated.AuctionType = existing.AuctionType
+		updated.Currency = existing.Currency
+		updated.IncrementRule = existing.IncrementRule
+		updated.ReservePrice = existing.ReservePrice
+		updated.EndTime = existing.EndTime
+		updated.SaleID = existing.SaleID
+	}
+	s.localCache[auctionID] = updated
+}
+
+// IsReserveMet reports whether amount reaches the auction's reserve price.
+// Auctions without a reserve always report true.
+func (s *BidService) IsReserveMet(ctx context.Context, auctionID string, amount domain.Money) bool {
+	if err := s.ensureAuctionCached(ctx, auctionID); err != nil {
+		s.log.Error("Failed to load auction cache", "auction_id", auctionID, "error", err)
+		return false
+	}
+
+	s.cacheMutex.RLock()
+	defer s.cacheMutex.RUnlock()
+
+	cached, exists := s.localCache[auctionID]
+	if !exists {
+		return false
+	}
+	return amount >= cached.ReservePrice
+}
+
+// GetAuctionState returns a copy of the auction's cached bidding state, loading it if needed
+func (s *BidService) GetAuctionState(ctx context.Context, auctionID string) (domain.LocalAuctionCache, error) {
+	if err := s.ensureAuctionCached(ctx, auctionID); err != nil {
+		return domain.LocalAuctionCache{}, err
+	}
+
+	s.cacheMutex.RLock()
+	defer s.cacheMutex.RUnlock()
+
+	cached, exists := s.localCache[auctionID]
+	if !exists {
+		return domain.LocalAuctionCache{AuctionID: auctionID}, nil
+	}
+	return *cached, nil
+}
+
+// SaleID returns the sale the auction is a lot of, or "" for a standalone auction.
+// Unlike GetAuctionState it never caches, so it is safe for auctions that just ended.
+func (s *BidService) SaleID(ctx context.Context, auctionID string) (string, error) {
+	s.cacheMutex.RLock()
+	cached, exists := s.localCache[auctionID]
+	s.cacheMutex.RUnlock()
+	if exists {
+		return cached.SaleID, nil
+	}
+
+	state, err := s.bidCache.GetCurrentBid(ctx, auctionID)
+	if err != nil {
+		return "", err
+	}
+	return state.SaleID, nil
 }
 
 func (s *BidService) RemoveFromCache(auctionID string) {
@@ -4,12 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"auction-system/internal/domain"
 
 	"github.com/go-redis/redis/v8"
 )
 
+// defaultRulesKey holds the tiers used when an auction is created without its own.
+// Per-auction tiers are read by the bid Lua script, so the key format is shared with it.
+const defaultRulesKey = "bid_validation_rules"
+
+func auctionRulesKey(auctionID string) string {
+	return fmt.Sprintf("%s:%s", defaultRulesKey, auctionID)
+}
+
 type BiddingRuleDaoImpl struct {
 	client *redis.Client
 	rules  *domain.BidValidationRules
@@ -22,56 +31,84 @@ func NewBiddingRuleDao(client *redis.Client) *BiddingRuleDaoImpl {
 }
 
 func (v *BiddingRuleDaoImpl) LoadRules(ctx context.Context) error {
-	data, err := v.client.Get(ctx, "bid_validation_rules").Result()
+	rules, err := v.getRules(ctx, defaultRulesKey)
+	if err != nil {
+		return err
+	}
+
+	// Missing or pre-tier rules are replaced with the built-in defaults
+	if rules == nil || rules.Validate() != nil {
+		v.rules = &domain.BidValidationRules{
+			Tiers: []domain.IncrementTier{
+				{From: 0, To: 10000, Increment: 500},
+				{From: 10000, To: 50000, Increment: 1000},
+				{From: 50000, Increment: 2500},
+			},
+		}
+		// Save to Redis
+		return v.saveRules(ctx, defaultRulesKey, v.rules)
+	}
+
+	v.rules = rules
+	return nil
+}
+
+func (v *BiddingRuleDaoImpl) DefaultTiers() []domain.IncrementTier {
+	if v.rules == nil {
+		return nil
+	}
+	return append([]domain.IncrementTier(nil), v.rules.Tiers...)
+}
+
+func (v *BiddingRuleDaoImpl) SaveAuctionRules(ctx context.Context, auctionID string, rules *domain.BidValidationRules) error {
+	return v.saveRules(ctx, auctionRulesKey(auctionID), rules)
+}
+
+// GetAuctionRules returns the auction's tiers, falling back to the defaults
+func (v *BiddingRuleDaoImpl) GetAuctionRules(ctx context.Context, auctionID string) (*domain.BidValidationRules, error) {
+	rules, err := v.getRules(ctx, auctionRulesKey(auctionID))
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		return &domain.BidValidationRules{Tiers: v.DefaultTiers()}, nil
+	}
+	return rules, nil
+}
+
+func (v *BiddingRuleDaoImpl) getRules(ctx context.Context, key string) (*domain.BidValidationRules, error) {
+	data, err := v.client.Get(ctx, key).Result()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
-			// TODO: Ideally this should be loaded into redis from config or some db.
-			// TODO: This should be configurable at an auction level and it should be a step during auction setup.
-			// Set default rules
-			v.rules = &domain.BidValidationRules{
-				Rules: map[string]float64{
-					"0-100":   5.0,
-					"100-500": 10.0,
-					"500+":    25.0,
-				},
-			}
-			// Save to Redis
-			return v.saveRules(ctx)
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 
 	var rules domain.BidValidationRules
 	if err := json.Unmarshal([]byte(data), &rules); err != nil {
-		return err
+		return nil, err
 	}
 
-	v.rules = &rules
-	return nil
+	return &rules, nil
 }
 
-func (v *BiddingRuleDaoImpl) saveRules(ctx context.Context) error {
-	data, err := json.Marshal(v.rules)
+func (v *BiddingRuleDaoImpl) saveRules(ctx context.Context, key string, rules *domain.BidValidationRules) error {
+	data, err := json.Marshal(rules)
 	if err != nil {
 		return err
 	}
 
-	return v.client.Set(ctx, "bid_validation_rules", string(data), 0).Err()
+	return v.client.Set(ctx, key, string(data), 0).Err()
 }
 
-func (v *BiddingRuleDaoImpl) GetMinimumBid(currentAmount float64) float64 {
+func (v *BiddingRuleDaoImpl) GetMinimumBid(currentAmount domain.Money) domain.Money {
 	return currentAmount + v.GetIncrementRule(currentAmount)
 }
 
-func (v *BiddingRuleDaoImpl) GetIncrementRule(amount float64) float64 {
+func (v *BiddingRuleDaoImpl) GetIncrementRule(amount domain.Money) domain.Money {
 	if v.rules == nil {
-		return 5.0 // default
-	}
-	if amount < 100 {
-		return v.rules.Rules["0-100"]
-	} else if amount < 500 {
-		return v.rules.Rules["100-500"]
-	} else {
-		return v.rules.Rules["500+"]
+		return 500 // default
 	}
+	return v.rules.GetIncrementRule(amount)
 }
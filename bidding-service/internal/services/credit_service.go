@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/domain/repositories"
+	"auction-system/pkg/logger"
+)
+
+// CreditService manages bidders' credit limits. MySQL keeps the limits; the
+// credit cache holds the copy the bid cache checks bids against, along with the holds.
+type CreditService struct {
+	creditRepo  repositories.CreditRepository
+	creditCache domain.CreditCache
+	log         logger.Logger
+}
+
+func NewCreditService(creditRepo repositories.CreditRepository, creditCache domain.CreditCache,
+	log logger.Logger) *CreditService {
+	return &CreditService{
+		creditRepo:  creditRepo,
+		creditCache: creditCache,
+		log:         log,
+	}
+}
+
+// LoadCreditLimits copies every stored limit into the credit cache, so a flushed
+// cache does not leave bidders unlimited
+func (cs *CreditService) LoadCreditLimits(ctx context.Context) error {
+	limits, err := cs.creditRepo.ListCreditLimits(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, limit := range limits {
+		if err := cs.creditCache.SetCreditLimit(ctx, limit); err != nil {
+			return err
+		}
+	}
+
+	cs.log.Info("Credit limits loaded", "count", len(limits))
+	return nil
+}
+
+// SetCreditLimit stores the limit and applies it to the bidder's next bids. Holds
+// already placed stay, even if they now exceed the limit.
+func (cs *CreditService) SetCreditLimit(ctx context.Context, limit *domain.CreditLimit) (*domain.CreditAccount, error) {
+	currency, err := domain.LookupCurrency(limit.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if err := currency.ValidateAmount(limit.Limit); err != nil {
+		return nil, err
+	}
+	limit.Currency = currency.Code
+
+	if err := cs.creditRepo.SetCreditLimit(ctx, limit); err != nil {
+		return nil, err
+	}
+	if err := cs.creditCache.SetCreditLimit(ctx, limit); err != nil {
+		return nil, err
+	}
+
+	cs.log.Info("Credit limit set", "user_id", limit.UserID, "currency", limit.Currency, "limit", limit.Limit)
+	return cs.creditCache.GetCreditAccount(ctx, limit.UserID, limit.Currency)
+}
+
+func (cs *CreditService) GetCreditAccount(ctx context.Context, userID, currencyCode string) (*domain.CreditAccount, error) {
+	currency, err := domain.LookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	return cs.creditCache.GetCreditAccount(ctx, userID, currency.Code)
+}
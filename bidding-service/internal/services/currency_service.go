@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"auction-system/internal/domain"
+	"auction-system/pkg/logger"
+)
+
+// CurrencyService converts auction amounts into the currency a client wants to
+// see them in. Conversions are for display only: bids are always placed and
+// validated in the auction's own currency.
+type CurrencyService struct {
+	rates domain.ExchangeRateProvider // nil when no exchange rates are configured
+	log   logger.Logger
+}
+
+func NewCurrencyService(rates domain.ExchangeRateProvider, log logger.Logger) *CurrencyService {
+	return &CurrencyService{
+		rates: rates,
+		log:   log,
+	}
+}
+
+// ConvertForDisplay converts amount between two currencies, rounded to the
+// minor unit of the target currency
+func (s *CurrencyService) ConvertForDisplay(ctx context.Context, amount domain.Money, from, to string) (domain.Money, error) {
+	fromCurrency, err := domain.LookupCurrency(from)
+	if err != nil {
+		return 0, err
+	}
+	toCurrency, err := domain.LookupCurrency(to)
+	if err != nil {
+		return 0, err
+	}
+
+	if fromCurrency.Code == toCurrency.Code {
+		return amount, nil
+	}
+	if s.rates == nil {
+		return 0, fmt.Errorf("%w: no exchange rates configured", domain.ErrExchangeRateNotFound)
+	}
+
+	rate, err := s.rates.Rate(ctx, fromCurrency.Code, toCurrency.Code)
+	if err != nil {
+		return 0, err
+	}
+	return toCurrency.Round(domain.Money(math.Round(float64(amount) * rate))), nil
+}
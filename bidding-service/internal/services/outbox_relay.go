@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"auction-system/internal/domain"
+)
+
+const (
+	// outboxGracePeriod is how long an outbox event is left to the request that
+	// recorded it before the relay publishes it instead
+	outboxGracePeriod = 30 * time.Second
+	outboxBatchSize   = 100
+)
+
+// publishOutboxEvent publishes an event recorded with a status change and marks
+// it sent. An event published twice, when the mark is lost, goes out once: the
+// publisher drops event IDs it has already published.
+func (am *AuctionManager) publishOutboxEvent(ctx context.Context, event *domain.BidEvent) error {
+	if err := am.eventPub.PublishBiddingEvent(ctx, event); err != nil {
+		return err
+	}
+
+	if err := am.outboxRepo.MarkEventSent(ctx, event.EventID, time.Now()); err != nil {
+		am.log.Error("Failed to mark outbox event sent", "event_id", event.EventID, "error", err)
+	}
+	return nil
+}
+
+// StartOutboxRelay publishes, every interval, the lifecycle events whose status
+// change was committed but which were never published, e.g. because the
+// instance stopped in between. Only the leader relays.
+func (am *AuctionManager) StartOutboxRelay(ctx context.Context, interval time.Duration) error {
+	am.log.Info("Starting outbox relay", "interval", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			am.relayOutbox(ctx)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (am *AuctionManager) relayOutbox(ctx context.Context) {
+	isLeader, err := am.leaderElection.IsLeader(ctx, am.instanceID)
+	if err != nil || !isLeader {
+		return
+	}
+
+	events, err := am.outboxRepo.ListUnsentEvents(ctx, time.Now().Add(-outboxGracePeriod), outboxBatchSize)
+	if err != nil {
+		am.log.Error("Failed to load outbox events", "error", err)
+		return
+	}
+
+	for _, event := range events {
+		am.log.Warn("Relaying unpublished outbox event", "event_id", event.EventID,
+			"type", event.Type, "auction_id", event.AuctionID)
+
+		// The end was recorded with its outcome, but its price and holds may not have been stored
+		if event.Type == domain.AuctionEndedBidRejected || event.Type == domain.AuctionReserveNotMet {
+			if err := am.finishEnd(ctx, event); err != nil {
+				am.log.Error("Failed to finish auction end", "auction_id", event.AuctionID, "error", err)
+				continue
+			}
+		}
+
+		if err := am.publishOutboxEvent(ctx, event); err != nil {
+			am.log.Error("Failed to relay outbox event", "event_id", event.EventID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/domain/repositories"
+	"auction-system/pkg/logger"
+	"auction-system/pkg/utils"
+)
+
+// RetractionService runs the bid retraction workflow: a bidder asks for a
+// mistyped English bid to be taken back and an admin approves or rejects it.
+// Approval replays the auction's bid ladder without the bid in Redis.
+type RetractionService struct {
+	retractionRepo repositories.RetractionRepository
+	auctionRepo    repositories.AuctionRepository
+	bidCache       domain.BidCache
+	biddingRuleDao domain.BiddingRule
+	log            logger.Logger
+}
+
+func NewRetractionService(retractionRepo repositories.RetractionRepository,
+	auctionRepo repositories.AuctionRepository, bidCache domain.BidCache,
+	biddingRuleDao domain.BiddingRule, log logger.Logger) *RetractionService {
+	return &RetractionService{
+		retractionRepo: retractionRepo,
+		auctionRepo:    auctionRepo,
+		bidCache:       bidCache,
+		biddingRuleDao: biddingRuleDao,
+		log:            log,
+	}
+}
+
+// RequestRetraction records a pending retraction. The bid must still be on the
+// ladder of a running English auction.
+func (rs *RetractionService) RequestRetraction(ctx context.Context, retraction *domain.BidRetraction) (*domain.BidRetraction, error) {
+	if _, err := rs.retractableAuction(ctx, retraction.AuctionID); err != nil {
+		return nil, err
+	}
+
+	ladder, err := rs.bidCache.GetBidLadder(ctx, retraction.AuctionID)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, bid := range ladder {
+		if retraction.Matches(bid) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, domain.ErrBidNotFound
+	}
+
+	retraction.ID = utils.GenerateID("retraction")
+	retraction.Status = domain.RetractionPending
+	retraction.RequestedAt = time.Now()
+
+	if err := rs.retractionRepo.CreateRetraction(ctx, retraction); err != nil {
+		return nil, err
+	}
+
+	rs.log.Info("Bid retraction requested", "retraction_id", retraction.ID,
+		"auction_id", retraction.AuctionID, "user_id", retraction.UserID)
+	return retraction, nil
+}
+
+func (rs *RetractionService) GetRetraction(ctx context.Context, retractionID string) (*domain.BidRetraction, error) {
+	return rs.retractionRepo.GetRetraction(ctx, retractionID)
+}
+
+func (rs *RetractionService) ListRetractions(ctx context.Context, auctionID string,
+	status domain.RetractionStatus) ([]*domain.BidRetraction, error) {
+	return rs.retractionRepo.ListRetractions(ctx, auctionID, status)
+}
+
+// GetBidLadder lets admins review an auction's bids before deciding on a retraction
+func (rs *RetractionService) GetBidLadder(ctx context.Context, auctionID string) ([]*domain.LadderBid, error) {
+	if _, err := rs.auctionRepo.GetAuction(ctx, auctionID); err != nil {
+		return nil, err
+	}
+	return rs.bidCache.GetBidLadder(ctx, auctionID)
+}
+
+// ApproveRetraction removes the bid and rolls the auction back to what the
+// remaining bids produce. The decision is stored first so two admins cannot
+// both act on one request; it is reopened if the rollback fails.
+func (rs *RetractionService) ApproveRetraction(ctx context.Context, retractionID, actor, note string) (*domain.BidRetraction, error) {
+	retraction, err := rs.retractionRepo.GetRetraction(ctx, retractionID)
+	if err != nil {
+		return nil, err
+	}
+	if retraction.Status != domain.RetractionPending {
+		return nil, fmt.Errorf("%w: retraction is %s", domain.ErrRetractionDecided, retraction.Status)
+	}
+
+	auction, err := rs.retractableAuction(ctx, retraction.AuctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := rs.biddingRuleDao.GetAuctionRules(ctx, auction.ID)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := domain.LookupCurrency(auction.Currency)
+	if err != nil {
+		return nil, err
+	}
+	startIncrement := currency.RoundIncrement(rules.GetIncrementRule(auction.StartBid))
+
+	if err := rs.decide(ctx, retraction, domain.RetractionApproved, actor, note); err != nil {
+		return nil, err
+	}
+
+	price, leaderID, err := rs.bidCache.RetractBid(ctx, retraction, auction.StartBid, startIncrement)
+	if err != nil {
+		retraction.Status = domain.RetractionPending
+		retraction.DecidedBy, retraction.DecisionNote, retraction.DecidedAt = "", "", time.Time{}
+		if reopenErr := rs.retractionRepo.DecideRetraction(ctx, retraction, domain.RetractionApproved); reopenErr != nil {
+			rs.log.Error("Failed to reopen retraction", "retraction_id", retractionID, "error", reopenErr)
+		}
+		return nil, err
+	}
+
+	rs.log.Info("Bid retracted", "retraction_id", retractionID, "auction_id", auction.ID,
+		"user_id", retraction.UserID, "actor", actor, "price", price, "leader_id", leaderID)
+	return retraction, nil
+}
+
+// RejectRetraction leaves the bid standing
+func (rs *RetractionService) RejectRetraction(ctx context.Context, retractionID, actor, note string) (*domain.BidRetraction, error) {
+	retraction, err := rs.retractionRepo.GetRetraction(ctx, retractionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.decide(ctx, retraction, domain.RetractionRejected, actor, note); err != nil {
+		return nil, err
+	}
+
+	rs.log.Info("Bid retraction rejected", "retraction_id", retractionID, "actor", actor)
+	return retraction, nil
+}
+
+func (rs *RetractionService) decide(ctx context.Context, retraction *domain.BidRetraction,
+	status domain.RetractionStatus, actor, note string) error {
+	retraction.Status = status
+	retraction.DecidedBy = actor
+	retraction.DecisionNote = note
+	retraction.DecidedAt = time.Now()
+	return rs.retractionRepo.DecideRetraction(ctx, retraction, domain.RetractionPending)
+}
+
+// retractableAuction loads the auction if its bids can still be retracted. Only
+// English auctions keep a ladder, and only while bidding has not ended.
+func (rs *RetractionService) retractableAuction(ctx context.Context, auctionID string) (*domain.Auction, error) {
+	auction, err := rs.auctionRepo.GetAuction(ctx, auctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if auction.Type != domain.AuctionEnglish {
+		return nil, fmt.Errorf("%w: %s auctions have no bid ladder", domain.ErrRetractionNotAllowed, auction.Type)
+	}
+	if auction.Status != domain.AuctionActive && auction.Status != domain.AuctionPaused {
+		return nil, fmt.Errorf("%w: auction is %s", domain.ErrRetractionNotAllowed, auction.Status)
+	}
+	return auction, nil
+}
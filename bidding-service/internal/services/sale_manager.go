@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"auction-system/internal/domain"
+	"auction-system/internal/domain/repositories"
+	"auction-system/pkg/logger"
+	"auction-system/pkg/utils"
+)
+
+// SaleManager runs catalogue sales. Each lot is a regular auction managed by the
+// AuctionManager; sale-level operations cascade to the lots.
+type SaleManager struct {
+	saleRepo       repositories.SaleRepository
+	auctionManager *AuctionManager
+	log            logger.Logger
+}
+
+func NewSaleManager(saleRepo repositories.SaleRepository, auctionManager *AuctionManager,
+	log logger.Logger) *SaleManager {
+	return &SaleManager{
+		saleRepo:       saleRepo,
+		auctionManager: auctionManager,
+		log:            log,
+	}
+}
+
+// CreateSale stores the sale and creates its lots in order. Every lot opens at
+// the sale's start time and gets its staggered end time from its lot number,
+// so the scheduler closes the lots one after another.
+//
+// Lots are checked before anything is created. A lot that still fails to be
+// created cancels the sale and the lots created before it, so no sale is left
+// open with only some of its lots.
+func (sm *SaleManager) CreateSale(ctx context.Context, sale *domain.Sale,
+	lots []*domain.Auction) (*domain.Sale, []*domain.Auction, error) {
+	for i, lot := range lots {
+		if err := sm.auctionManager.checkAuction(ctx, lot); err != nil {
+			return nil, nil, fmt.Errorf("lot %d: %w", i+1, err)
+		}
+	}
+
+	sale.ID = utils.GenerateID("sale")
+	sale.Status = domain.SaleOpen
+	sale.CreatedAt = time.Now()
+	sale.UpdatedAt = sale.CreatedAt
+
+	if err := sm.saleRepo.CreateSale(ctx, sale); err != nil {
+		return nil, nil, err
+	}
+
+	for i, lot := range lots {
+		lot.SaleID = sale.ID
+		lot.LotNumber = i + 1
+		lot.StartTime = sale.StartTime
+		lot.EndTime = sale.LotEndTime(lot.LotNumber)
+
+		if _, err := sm.auctionManager.CreateAuction(ctx, lot); err != nil {
+			sm.abandonSale(ctx, sale, lots[:i])
+			return nil, nil, fmt.Errorf("lot %d: %w", lot.LotNumber, err)
+		}
+	}
+
+	sm.log.Info("Sale created", "sale_id", sale.ID, "lots", len(lots))
+	return sale, lots, nil
+}
+
+// abandonSale cancels a sale whose lots could not all be created, along with
+// the lots that were. Failures are logged; the caller reports the original error.
+func (sm *SaleManager) abandonSale(ctx context.Context, sale *domain.Sale, created []*domain.Auction) {
+	sm.log.Warn("Cancelling partly created sale", "sale_id", sale.ID, "lots_created", len(created))
+
+	for _, lot := range created {
+		err := sm.auctionManager.CancelAuction(ctx, lot.ID, domain.ActorSystem, "sale creation failed")
+		if err != nil {
+			sm.log.Error("Failed to cancel lot", "sale_id", sale.ID, "auction_id", lot.ID, "error", err)
+		}
+	}
+
+	if err := sm.saleRepo.UpdateSaleStatus(ctx, sale.ID, domain.SaleCancelled); err != nil {
+		sm.log.Error("Failed to cancel sale", "sale_id", sale.ID, "error", err)
+	}
+}
+
+func (sm *SaleManager) GetSale(ctx context.Context, saleID string) (*domain.Sale, []*domain.Auction, error) {
+	sale, err := sm.saleRepo.GetSale(ctx, saleID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lots, err := sm.saleRepo.GetSaleLots(ctx, saleID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sale, lots, nil
+}
+
+// CancelSale cancels every lot that has not finished yet
+func (sm *SaleManager) CancelSale(ctx context.Context, saleID, actor, reason string) error {
+	return sm.cascade(ctx, saleID, "cancelled", domain.SaleCancelled, actor, reason,
+		func(sale *domain.Sale, lots []*domain.Auction) error {
+			if sale.Status == domain.SaleCancelled {
+				return fmt.Errorf("%w: sale is already cancelled", domain.ErrInvalidStateTransition)
+			}
+			return nil
+		},
+		func(lot *domain.Auction) bool {
+			return lot.Status.CanTransitionTo(domain.AuctionCancelled)
+		},
+		sm.auctionManager.CancelAuction)
+}
+
+// PauseSale pauses every running lot. A sale whose lots have not opened yet cannot be paused.
+func (sm *SaleManager) PauseSale(ctx context.Context, saleID, actor, reason string) error {
+	return sm.cascade(ctx, saleID, "paused", domain.SalePaused, actor, reason,
+		func(sale *domain.Sale, lots []*domain.Auction) error {
+			if sale.Status != domain.SaleOpen {
+				return fmt.Errorf("%w: cannot pause a %s sale", domain.ErrInvalidStateTransition, sale.Status)
+			}
+			for _, lot := range lots {
+				if lot.Status == domain.AuctionPending {
+					return fmt.Errorf("%w: sale has not started yet", domain.ErrInvalidStateTransition)
+				}
+			}
+			return nil
+		},
+		func(lot *domain.Auction) bool {
+			return lot.Status == domain.AuctionActive
+		},
+		sm.auctionManager.PauseAuction)
+}
+
+// ResumeSale resumes every paused lot. Each lot's end moves out by the same pause
+// length, so the staggered close order is kept.
+func (sm *SaleManager) ResumeSale(ctx context.Context, saleID, actor, reason string) error {
+	return sm.cascade(ctx, saleID, "resumed", domain.SaleOpen, actor, reason,
+		func(sale *domain.Sale, lots []*domain.Auction) error {
+			if sale.Status != domain.SalePaused {
+				return fmt.Errorf("%w: cannot resume a %s sale", domain.ErrInvalidStateTransition, sale.Status)
+			}
+			return nil
+		},
+		func(lot *domain.Auction) bool {
+			return lot.Status == domain.AuctionPaused
+		},
+		sm.auctionManager.ResumeAuction)
+}
+
+// cascade applies a lot-level operation to every matching lot and then records
+// the sale's new status. The sale status only changes once all lots succeeded,
+// so a partly failed operation can simply be retried.
+func (sm *SaleManager) cascade(ctx context.Context, saleID, action string, to domain.SaleStatus, actor, reason string,
+	check func(sale *domain.Sale, lots []*domain.Auction) error,
+	applies func(lot *domain.Auction) bool,
+	apply func(ctx context.Context, auctionID, actor, reason string) error) error {
+	sale, lots, err := sm.GetSale(ctx, saleID)
+	if err != nil {
+		return err
+	}
+
+	if err := check(sale, lots); err != nil {
+		return err
+	}
+
+	sm.log.Info("Changing sale status", "sale_id", saleID, "from", sale.Status, "to", to, "actor", actor)
+
+	lotReason := fmt.Sprintf("sale %s %s", saleID, action)
+	if reason != "" {
+		lotReason += ": " + reason
+	}
+
+	var errs []error
+	for _, lot := range lots {
+		if !applies(lot) {
+			continue
+		}
+		if err := apply(ctx, lot.ID, actor, lotReason); err != nil {
+			sm.log.Error("Failed to update lot", "sale_id", saleID, "auction_id", lot.ID, "error", err)
+			errs = append(errs, fmt.Errorf("lot %d: %w", lot.LotNumber, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return sm.saleRepo.UpdateSaleStatus(ctx, saleID, to)
+}
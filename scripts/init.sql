@@ -9,47 +9,171 @@ CREATE DATABASE IF NOT EXISTS auction_db CHARACTER SET utf8mb4 COLLATE utf8mb4_u
 USE auction_db;
 
 -- Drop existing tables if they exist (for clean restart)
+DROP TABLE IF EXISTS bidder_credit_limits;
+DROP TABLE IF EXISTS bid_retractions;
 DROP TABLE IF EXISTS bid_events;
 DROP TABLE IF EXISTS scheduled_jobs;
+DROP TABLE IF EXISTS event_outbox;
+DROP TABLE IF EXISTS auction_status_history;
 DROP TABLE IF EXISTS auctions;
+DROP TABLE IF EXISTS sales;
+DROP TABLE IF EXISTS items;
+DROP TABLE IF EXISTS categories;
+
+-- Create categories table; parent_id builds the category tree
+CREATE TABLE categories (
+                            id VARCHAR(255) PRIMARY KEY,
+                            parent_id VARCHAR(255) NULL DEFAULT NULL,
+                            name VARCHAR(255) NOT NULL,
+                            created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                            INDEX idx_parent_id (parent_id),
+                            FOREIGN KEY (parent_id) REFERENCES categories(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create items table
+CREATE TABLE items (
+                       id VARCHAR(255) PRIMARY KEY,
+                       seller_id VARCHAR(255) NOT NULL,
+                       title VARCHAR(255) NOT NULL,
+                       description TEXT NOT NULL,
+                       item_condition VARCHAR(32) NOT NULL COMMENT 'new, like_new, used, refurbished, for_parts',
+                       category_id VARCHAR(255) NULL DEFAULT NULL,
+                       images JSON NOT NULL COMMENT 'image URLs or storage references, first is the cover',
+                       attributes JSON NOT NULL,
+                       created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                       updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+                       INDEX idx_seller_id (seller_id),
+                       INDEX idx_category_id (category_id),
+                       FOREIGN KEY (category_id) REFERENCES categories(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create sales table (catalogue sales grouping many lots)
+CREATE TABLE sales (
+                       id VARCHAR(255) PRIMARY KEY,
+                       title VARCHAR(255) NOT NULL,
+                       start_time TIMESTAMP NOT NULL,
+                       first_lot_close TIMESTAMP NOT NULL,
+                       lot_interval_seconds INT NOT NULL COMMENT 'lot N closes at first_lot_close + (N-1) * interval',
+                       status VARCHAR(32) NOT NULL DEFAULT 'open' COMMENT 'open, paused, cancelled',
+                       created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                       updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+                       INDEX idx_start_time (start_time)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
 -- Create auctions table
 CREATE TABLE auctions (
                           id VARCHAR(255) PRIMARY KEY,
                           start_time TIMESTAMP NOT NULL,
                           end_time TIMESTAMP NOT NULL,
-                          start_bid   FLOAT NOT NULL,
-                          status INT NOT NULL DEFAULT 0 COMMENT '0=pending, 1=active, 2=ended, 3=cancelled',
+                          start_bid DECIMAL(15,2) NOT NULL,
+                          currency CHAR(3) NOT NULL DEFAULT 'USD' COMMENT 'ISO 4217; every amount of the auction is in this currency',
+                          auction_type VARCHAR(32) NOT NULL DEFAULT 'english' COMMENT 'english, dutch, sealed_first_price, sealed_second_price',
+                          reserve_price DECIMAL(15,2) NOT NULL DEFAULT 0 COMMENT 'hidden reserve, 0 = no reserve',
+                          buy_now_price DECIMAL(15,2) NOT NULL DEFAULT 0 COMMENT '0 = buy-it-now not offered',
+                          buy_now_until TIMESTAMP NULL DEFAULT NULL COMMENT 'NULL = available until end_time',
+                          status INT NOT NULL DEFAULT 0 COMMENT '0=pending, 1=active, 2=ended, 3=cancelled, 4=paused, 5=settled',
+                          paused_at TIMESTAMP NULL DEFAULT NULL,
                           created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
                           updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+                          dutch_price_step DECIMAL(15,2) NOT NULL DEFAULT 0,
+                          dutch_step_interval_seconds INT NOT NULL DEFAULT 0,
+                          dutch_floor_price DECIMAL(15,2) NOT NULL DEFAULT 0,
+                          original_end_time TIMESTAMP NULL DEFAULT NULL COMMENT 'end_time before soft-close extensions',
+                          soft_close_window_seconds INT NOT NULL DEFAULT 0 COMMENT '0 = soft close disabled',
+                          soft_close_extension_seconds INT NOT NULL DEFAULT 0,
+                          soft_close_max_extension_seconds INT NOT NULL DEFAULT 0 COMMENT '0 = uncapped',
+                          seller_id VARCHAR(255) NOT NULL DEFAULT '',
+                          title VARCHAR(255) NOT NULL DEFAULT '',
+                          category VARCHAR(255) NOT NULL DEFAULT '',
+                          current_price DECIMAL(15,2) NOT NULL DEFAULT 0 COMMENT 'last visible price, synced from bid events',
+                          item_id VARCHAR(255) NULL DEFAULT NULL COMMENT 'title and category are copied from the item',
+                          sale_id VARCHAR(255) NULL DEFAULT NULL,
+                          lot_number INT NOT NULL DEFAULT 0 COMMENT '1-based position within the sale, 0 = standalone',
                           INDEX idx_status (status),
                           INDEX idx_start_time (start_time),
                           INDEX idx_end_time (end_time),
-                          INDEX idx_created_at (created_at)
+                          INDEX idx_created_at (created_at),
+                          INDEX idx_status_end_time (status, end_time, id),
+                          INDEX idx_status_price (status, current_price, id),
+                          INDEX idx_status_created_at (status, created_at, id),
+                          INDEX idx_seller_id (seller_id, end_time),
+                          INDEX idx_category (category, end_time),
+                          INDEX idx_currency_price (currency, current_price),
+                          FULLTEXT INDEX ft_title (title),
+                          INDEX idx_item_id (item_id),
+                          FOREIGN KEY (item_id) REFERENCES items(id),
+                          INDEX idx_sale_lot (sale_id, lot_number),
+                          FOREIGN KEY (sale_id) REFERENCES sales(id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
 -- Create bid events table for analytics
 CREATE TABLE bid_events (
                             id BIGINT AUTO_INCREMENT PRIMARY KEY,
+                            event_id VARCHAR(64) NULL DEFAULT NULL COMMENT 'NULL = legacy colon-delimited event',
                             auction_id VARCHAR(255) NOT NULL,
                             user_id VARCHAR(255) NOT NULL,
                             amount DECIMAL(15,2) NOT NULL,
                             event_type VARCHAR(50) NOT NULL,
                             timestamp TIMESTAMP NOT NULL,
+                            bid_id VARCHAR(64) NULL DEFAULT NULL COMMENT 'client-generated idempotency key of the bid behind the event',
                             created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
                             INDEX idx_auction_id (auction_id),
                             INDEX idx_user_id (user_id),
                             INDEX idx_timestamp (timestamp),
+                            UNIQUE KEY uk_event_id (event_id),
                             INDEX idx_event_type (event_type),
                             INDEX idx_created_at (created_at),
                             FOREIGN KEY (auction_id) REFERENCES auctions(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
+-- Create bid retractions table (bidder requests, admin decisions)
+CREATE TABLE bid_retractions (
+                                 id VARCHAR(255) PRIMARY KEY,
+                                 auction_id VARCHAR(255) NOT NULL,
+                                 user_id VARCHAR(255) NOT NULL,
+                                 bid_id VARCHAR(64) NULL DEFAULT NULL COMMENT 'NULL = the bid is named by amount',
+                                 amount DECIMAL(15,2) NOT NULL DEFAULT 0,
+                                 reason VARCHAR(512) NOT NULL DEFAULT '',
+                                 status VARCHAR(32) NOT NULL DEFAULT 'pending' COMMENT 'pending, approved, rejected',
+                                 requested_at TIMESTAMP NOT NULL,
+                                 decided_by VARCHAR(255) NOT NULL DEFAULT '',
+                                 decision_note VARCHAR(512) NOT NULL DEFAULT '',
+                                 decided_at TIMESTAMP NULL DEFAULT NULL,
+                                 INDEX idx_auction_id (auction_id, requested_at),
+                                 INDEX idx_status (status, requested_at),
+                                 FOREIGN KEY (auction_id) REFERENCES auctions(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create bidder credit limits table; holds live in Redis next to the bidding state
+CREATE TABLE bidder_credit_limits (
+                                      user_id VARCHAR(255) NOT NULL,
+                                      currency CHAR(3) NOT NULL,
+                                      credit_limit DECIMAL(15,2) NOT NULL,
+                                      updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                      PRIMARY KEY (user_id, currency)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Lifecycle events written with the status change they announce and
+-- published from here, so a crash in between cannot lose them
+CREATE TABLE event_outbox (
+                              id VARCHAR(64) PRIMARY KEY COMMENT 'event ID of the published event',
+                              auction_id VARCHAR(255) NOT NULL,
+                              event_type VARCHAR(50) NOT NULL,
+                              user_id VARCHAR(255) NOT NULL DEFAULT '',
+                              amount DECIMAL(15,2) NOT NULL DEFAULT 0,
+                              bid_id VARCHAR(64) NULL DEFAULT NULL,
+                              occurred_at TIMESTAMP NOT NULL,
+                              created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                              sent_at TIMESTAMP NULL DEFAULT NULL COMMENT 'NULL = not published yet',
+                              INDEX idx_unsent (sent_at, created_at),
+                              FOREIGN KEY (auction_id) REFERENCES auctions(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
 -- Create scheduled jobs table
 CREATE TABLE scheduled_jobs (
                                 id VARCHAR(255) PRIMARY KEY,
                                 auction_id VARCHAR(255) NOT NULL,
-                                job_type VARCHAR(50) NOT NULL COMMENT 'start_auction, end_auction',
+                                job_type VARCHAR(50) NOT NULL COMMENT 'start_auction, end_auction, price_drop',
                                 run_at TIMESTAMP NOT NULL,
                                 status VARCHAR(50) NOT NULL DEFAULT 'pending' COMMENT 'pending, executed, cancelled',
                                 created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -61,6 +185,19 @@ CREATE TABLE scheduled_jobs (
                                 FOREIGN KEY (auction_id) REFERENCES auctions(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
+-- Create auction status history table
+CREATE TABLE auction_status_history (
+                                        id BIGINT AUTO_INCREMENT PRIMARY KEY,
+                                        auction_id VARCHAR(255) NOT NULL,
+                                        from_status INT NOT NULL,
+                                        to_status INT NOT NULL,
+                                        actor VARCHAR(255) NOT NULL,
+                                        reason VARCHAR(512) NOT NULL DEFAULT '',
+                                        created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+                                        INDEX idx_auction_id (auction_id, id),
+                                        FOREIGN KEY (auction_id) REFERENCES auctions(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
 -- Insert sample data for testing (optional)
 INSERT INTO auctions (id, start_time, end_time, status, created_at, updated_at) VALUES
     ('auction_sample_001', NOW() + INTERVAL 5 MINUTE, NOW() + INTERVAL 65 MINUTE, 0, NOW(), NOW());